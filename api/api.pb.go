@@ -20,6 +20,115 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TestOutcome is how running a test to collect its coverage ended
+type TestOutcome int32
+
+const (
+	TestOutcome_PASS        TestOutcome = 0
+	TestOutcome_FAIL        TestOutcome = 1
+	TestOutcome_SKIP        TestOutcome = 2
+	TestOutcome_BUILD_ERROR TestOutcome = 3
+	TestOutcome_TIMEOUT     TestOutcome = 4
+)
+
+// Enum value maps for TestOutcome.
+var (
+	TestOutcome_name = map[int32]string{
+		0: "PASS",
+		1: "FAIL",
+		2: "SKIP",
+		3: "BUILD_ERROR",
+		4: "TIMEOUT",
+	}
+	TestOutcome_value = map[string]int32{
+		"PASS":        0,
+		"FAIL":        1,
+		"SKIP":        2,
+		"BUILD_ERROR": 3,
+		"TIMEOUT":     4,
+	}
+)
+
+func (x TestOutcome) Enum() *TestOutcome {
+	p := new(TestOutcome)
+	*p = x
+	return p
+}
+
+func (x TestOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[0].Descriptor()
+}
+
+func (TestOutcome) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[0]
+}
+
+func (x TestOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestOutcome.Descriptor instead.
+func (TestOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0}
+}
+
+// TestKind is the kind of function a step of the log was built from
+type TestKind int32
+
+const (
+	TestKind_TEST    TestKind = 0
+	TestKind_EXAMPLE TestKind = 1
+	TestKind_FUZZ    TestKind = 2
+)
+
+// Enum value maps for TestKind.
+var (
+	TestKind_name = map[int32]string{
+		0: "TEST",
+		1: "EXAMPLE",
+		2: "FUZZ",
+	}
+	TestKind_value = map[string]int32{
+		"TEST":    0,
+		"EXAMPLE": 1,
+		"FUZZ":    2,
+	}
+)
+
+func (x TestKind) Enum() *TestKind {
+	p := new(TestKind)
+	*p = x
+	return p
+}
+
+func (x TestKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[1].Descriptor()
+}
+
+func (TestKind) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[1]
+}
+
+func (x TestKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestKind.Descriptor instead.
+func (TestKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1}
+}
+
+// SortType is how the tests of a log are ordered. How long tests take
+// only breaks ties: of two tests a sort has no other preference between,
+// the quicker comes first
 type StartJobRequest_SortType int32
 
 const (
@@ -27,6 +136,25 @@ const (
 	StartJobRequest_RAW        StartJobRequest_SortType = 1
 	StartJobRequest_NET        StartJobRequest_SortType = 2
 	StartJobRequest_IMPORTANCE StartJobRequest_SortType = 3
+	// quickest tests first, by how long they took to run, then by the
+	// fewest lines covered
+	StartJobRequest_DURATION StartJobRequest_SortType = 4
+	// hottest code first, by how many times the lines each test covers
+	// run across every test. Tests are run with -covermode=count unless
+	// the job sets a cover mode
+	StartJobRequest_FREQUENCY StartJobRequest_SortType = 5
+	// the fewest tests that together cover everything the whole suite
+	// covers, smallest first. The other tests are reported as redundant
+	StartJobRequest_MINIMAL StartJobRequest_SortType = 6
+	// functions before the functions calling them, by the static call graph
+	// of the covered source
+	StartJobRequest_CALLGRAPH StartJobRequest_SortType = 7
+	// every test after the tests whose coverage its coverage contains, which
+	// are reported in the refinements of the job's results
+	StartJobRequest_CONTAINMENT StartJobRequest_SortType = 8
+	// tests covering similar code grouped into chapters, which are reported
+	// in the chapters of the job's results
+	StartJobRequest_CHAPTERS StartJobRequest_SortType = 9
 )
 
 // Enum value maps for StartJobRequest_SortType.
@@ -36,12 +164,24 @@ var (
 		1: "RAW",
 		2: "NET",
 		3: "IMPORTANCE",
+		4: "DURATION",
+		5: "FREQUENCY",
+		6: "MINIMAL",
+		7: "CALLGRAPH",
+		8: "CONTAINMENT",
+		9: "CHAPTERS",
 	}
 	StartJobRequest_SortType_value = map[string]int32{
-		"HARDCODED":  0,
-		"RAW":        1,
-		"NET":        2,
-		"IMPORTANCE": 3,
+		"HARDCODED":   0,
+		"RAW":         1,
+		"NET":         2,
+		"IMPORTANCE":  3,
+		"DURATION":    4,
+		"FREQUENCY":   5,
+		"MINIMAL":     6,
+		"CALLGRAPH":   7,
+		"CONTAINMENT": 8,
+		"CHAPTERS":    9,
 	}
 )
 
@@ -56,11 +196,11 @@ func (x StartJobRequest_SortType) String() string {
 }
 
 func (StartJobRequest_SortType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_enumTypes[0].Descriptor()
+	return file_api_proto_enumTypes[2].Descriptor()
 }
 
 func (StartJobRequest_SortType) Type() protoreflect.EnumType {
-	return &file_api_proto_enumTypes[0]
+	return &file_api_proto_enumTypes[2]
 }
 
 func (x StartJobRequest_SortType) Number() protoreflect.EnumNumber {
@@ -72,14 +212,234 @@ func (StartJobRequest_SortType) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// FailurePolicy is what to do with tests that don't pass. Tests that fail
+// to build always fail the job.
+type StartJobRequest_FailurePolicy int32
+
+const (
+	// fail the job as soon as a test fails or times out. Skipped tests are
+	// kept in the log
+	StartJobRequest_FAIL_FAST StartJobRequest_FailurePolicy = 0
+	// leave failing, timed out and skipped tests out of the log
+	StartJobRequest_DROP_FAILING StartJobRequest_FailurePolicy = 1
+	// build the log with whatever coverage failing, timed out and skipped
+	// tests collected before they stopped
+	StartJobRequest_KEEP_PARTIAL StartJobRequest_FailurePolicy = 2
+)
+
+// Enum value maps for StartJobRequest_FailurePolicy.
+var (
+	StartJobRequest_FailurePolicy_name = map[int32]string{
+		0: "FAIL_FAST",
+		1: "DROP_FAILING",
+		2: "KEEP_PARTIAL",
+	}
+	StartJobRequest_FailurePolicy_value = map[string]int32{
+		"FAIL_FAST":    0,
+		"DROP_FAILING": 1,
+		"KEEP_PARTIAL": 2,
+	}
+)
+
+func (x StartJobRequest_FailurePolicy) Enum() *StartJobRequest_FailurePolicy {
+	p := new(StartJobRequest_FailurePolicy)
+	*p = x
+	return p
+}
+
+func (x StartJobRequest_FailurePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartJobRequest_FailurePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[3].Descriptor()
+}
+
+func (StartJobRequest_FailurePolicy) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[3]
+}
+
+func (x StartJobRequest_FailurePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartJobRequest_FailurePolicy.Descriptor instead.
+func (StartJobRequest_FailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type JobSummary_State int32
+
+const (
+	JobSummary_RUNNING   JobSummary_State = 0
+	JobSummary_COMPLETE  JobSummary_State = 1
+	JobSummary_FAILED    JobSummary_State = 2
+	JobSummary_CANCELLED JobSummary_State = 3
+)
+
+// Enum value maps for JobSummary_State.
+var (
+	JobSummary_State_name = map[int32]string{
+		0: "RUNNING",
+		1: "COMPLETE",
+		2: "FAILED",
+		3: "CANCELLED",
+	}
+	JobSummary_State_value = map[string]int32{
+		"RUNNING":   0,
+		"COMPLETE":  1,
+		"FAILED":    2,
+		"CANCELLED": 3,
+	}
+)
+
+func (x JobSummary_State) Enum() *JobSummary_State {
+	p := new(JobSummary_State)
+	*p = x
+	return p
+}
+
+func (x JobSummary_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobSummary_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[4].Descriptor()
+}
+
+func (JobSummary_State) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[4]
+}
+
+func (x JobSummary_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobSummary_State.Descriptor instead.
+func (JobSummary_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type JobProgress_Phase int32
+
+const (
+	JobProgress_INITIALIZING        JobProgress_Phase = 0
+	JobProgress_COLLECTING_COVERAGE JobProgress_Phase = 1
+	JobProgress_SORTING             JobProgress_Phase = 2
+	JobProgress_BUILDING_STEP       JobProgress_Phase = 3
+	JobProgress_PRUNING_DEAD_CODE   JobProgress_Phase = 4
+	JobProgress_RENDERING           JobProgress_Phase = 5
+)
+
+// Enum value maps for JobProgress_Phase.
+var (
+	JobProgress_Phase_name = map[int32]string{
+		0: "INITIALIZING",
+		1: "COLLECTING_COVERAGE",
+		2: "SORTING",
+		3: "BUILDING_STEP",
+		4: "PRUNING_DEAD_CODE",
+		5: "RENDERING",
+	}
+	JobProgress_Phase_value = map[string]int32{
+		"INITIALIZING":        0,
+		"COLLECTING_COVERAGE": 1,
+		"SORTING":             2,
+		"BUILDING_STEP":       3,
+		"PRUNING_DEAD_CODE":   4,
+		"RENDERING":           5,
+	}
+)
+
+func (x JobProgress_Phase) Enum() *JobProgress_Phase {
+	p := new(JobProgress_Phase)
+	*p = x
+	return p
+}
+
+func (x JobProgress_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobProgress_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[5].Descriptor()
+}
+
+func (JobProgress_Phase) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[5]
+}
+
+func (x JobProgress_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobProgress_Phase.Descriptor instead.
+func (JobProgress_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type JobEvent_Type int32
+
+const (
+	JobEvent_STATUS JobEvent_Type = 0
+	JobEvent_STEP   JobEvent_Type = 1
+	JobEvent_DONE   JobEvent_Type = 2
+)
+
+// Enum value maps for JobEvent_Type.
+var (
+	JobEvent_Type_name = map[int32]string{
+		0: "STATUS",
+		1: "STEP",
+		2: "DONE",
+	}
+	JobEvent_Type_value = map[string]int32{
+		"STATUS": 0,
+		"STEP":   1,
+		"DONE":   2,
+	}
+)
+
+func (x JobEvent_Type) Enum() *JobEvent_Type {
+	p := new(JobEvent_Type)
+	*p = x
+	return p
+}
+
+func (x JobEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[6].Descriptor()
+}
+
+func (JobEvent_Type) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[6]
+}
+
+func (x JobEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobEvent_Type.Descriptor instead.
+func (JobEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19, 0}
+}
+
 type StartJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tests []string                 `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
-	Pkg   string                   `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
-	Sort  StartJobRequest_SortType `protobuf:"varint,3,opt,name=sort,proto3,enum=StartJobRequest_SortType" json:"sort,omitempty"`
+	Tests         []string                      `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+	Pkg           string                        `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Sort          StartJobRequest_SortType      `protobuf:"varint,3,opt,name=sort,proto3,enum=StartJobRequest_SortType" json:"sort,omitempty"`
+	Options       *TestOptions                  `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	FailurePolicy StartJobRequest_FailurePolicy `protobuf:"varint,5,opt,name=failure_policy,json=failurePolicy,proto3,enum=StartJobRequest_FailurePolicy" json:"failure_policy,omitempty"`
+	// constraints the order of the log has to satisfy. The sort orders the
+	// tests they leave free. Ignored by HARDCODED sorts
+	Constraints *SortConstraints `protobuf:"bytes,6,opt,name=constraints,proto3" json:"constraints,omitempty"`
 }
 
 func (x *StartJobRequest) Reset() {
@@ -135,16 +495,45 @@ func (x *StartJobRequest) GetSort() StartJobRequest_SortType {
 	return StartJobRequest_HARDCODED
 }
 
-type StartJobResponse struct {
+func (x *StartJobRequest) GetOptions() *TestOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *StartJobRequest) GetFailurePolicy() StartJobRequest_FailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return StartJobRequest_FAIL_FAST
+}
+
+func (x *StartJobRequest) GetConstraints() *SortConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+// SortConstraints fix parts of the order of a log. Constraints on tests that
+// aren't in the log, because they were left out or aren't among the job's
+// tests, are ignored
+type SortConstraints struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tests that start the log, in this order
+	First []string `protobuf:"bytes,1,rep,name=first,proto3" json:"first,omitempty"`
+	// tests that end the log, in this order
+	Last   []string                 `protobuf:"bytes,2,rep,name=last,proto3" json:"last,omitempty"`
+	Pins   []*SortConstraints_Pin   `protobuf:"bytes,3,rep,name=pins,proto3" json:"pins,omitempty"`
+	Orders []*SortConstraints_Order `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"`
 }
 
-func (x *StartJobResponse) Reset() {
-	*x = StartJobResponse{}
+func (x *SortConstraints) Reset() {
+	*x = SortConstraints{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -152,13 +541,13 @@ func (x *StartJobResponse) Reset() {
 	}
 }
 
-func (x *StartJobResponse) String() string {
+func (x *SortConstraints) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartJobResponse) ProtoMessage() {}
+func (*SortConstraints) ProtoMessage() {}
 
-func (x *StartJobResponse) ProtoReflect() protoreflect.Message {
+func (x *SortConstraints) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -170,28 +559,71 @@ func (x *StartJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartJobResponse.ProtoReflect.Descriptor instead.
-func (*StartJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortConstraints.ProtoReflect.Descriptor instead.
+func (*SortConstraints) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *StartJobResponse) GetId() string {
+func (x *SortConstraints) GetFirst() []string {
 	if x != nil {
-		return x.Id
+		return x.First
 	}
-	return ""
+	return nil
 }
 
-type CheckoutFilesRequest struct {
+func (x *SortConstraints) GetLast() []string {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *SortConstraints) GetPins() []*SortConstraints_Pin {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+func (x *SortConstraints) GetOrders() []*SortConstraints_Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// TestOptions configures how tests are run to collect coverage
+type TestOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files *FileMap `protobuf:"bytes,1,opt,name=files,proto3" json:"files,omitempty"`
+	// packages to collect coverage for, as for go test -coverpkg. Defaults to
+	// the package under test
+	CoverPkgs []string `protobuf:"bytes,1,rep,name=cover_pkgs,json=coverPkgs,proto3" json:"cover_pkgs,omitempty"`
+	// build tags, as for go test -tags
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// environment variables to build and run the tests with, in addition to
+	// the server's own environment
+	Env map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// time limit for each test, as a go duration like "30s", as for go test
+	// -timeout. Defaults to go test's default
+	Timeout string `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Short   bool   `protobuf:"varint,5,opt,name=short,proto3" json:"short,omitempty"`
+	// number of times to run each test, as for go test -count. Defaults to 1
+	Count int32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// extra arguments passed to go test after the others, when listing tests
+	// as well as running them. Since they can't be split between building
+	// and running a test binary, tests with args are each run with go test
+	// rather than a single binary built for the job, which is slower
+	Args []string `protobuf:"bytes,7,rep,name=args,proto3" json:"args,omitempty"`
+	// coverage mode, as for go test -covermode: set, count or atomic. count
+	// and atomic record how many times each block ran. Defaults to set
+	CoverMode string `protobuf:"bytes,8,opt,name=cover_mode,json=coverMode,proto3" json:"cover_mode,omitempty"`
 }
 
-func (x *CheckoutFilesRequest) Reset() {
-	*x = CheckoutFilesRequest{}
+func (x *TestOptions) Reset() {
+	*x = TestOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,13 +631,13 @@ func (x *CheckoutFilesRequest) Reset() {
 	}
 }
 
-func (x *CheckoutFilesRequest) String() string {
+func (x *TestOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckoutFilesRequest) ProtoMessage() {}
+func (*TestOptions) ProtoMessage() {}
 
-func (x *CheckoutFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *TestOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -217,31 +649,77 @@ func (x *CheckoutFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckoutFilesRequest.ProtoReflect.Descriptor instead.
-func (*CheckoutFilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestOptions.ProtoReflect.Descriptor instead.
+func (*TestOptions) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CheckoutFilesRequest) GetFiles() *FileMap {
+func (x *TestOptions) GetCoverPkgs() []string {
 	if x != nil {
-		return x.Files
+		return x.CoverPkgs
 	}
 	return nil
 }
 
-type JobStatusResponse struct {
+func (x *TestOptions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TestOptions) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *TestOptions) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *TestOptions) GetShort() bool {
+	if x != nil {
+		return x.Short
+	}
+	return false
+}
+
+func (x *TestOptions) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TestOptions) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TestOptions) GetCoverMode() string {
+	if x != nil {
+		return x.CoverMode
+	}
+	return ""
+}
+
+type StartJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Complete bool        `protobuf:"varint,1,opt,name=complete,proto3" json:"complete,omitempty"`
-	Details  string      `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	Error    string      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	Results  *JobResults `protobuf:"bytes,4,opt,name=results,proto3" json:"results,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *JobStatusResponse) Reset() {
-	*x = JobStatusResponse{}
+func (x *StartJobResponse) Reset() {
+	*x = StartJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,13 +727,13 @@ func (x *JobStatusResponse) Reset() {
 	}
 }
 
-func (x *JobStatusResponse) String() string {
+func (x *StartJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobStatusResponse) ProtoMessage() {}
+func (*StartJobResponse) ProtoMessage() {}
 
-func (x *JobStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *StartJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -267,65 +745,1292 @@ func (x *JobStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use StartJobResponse.ProtoReflect.Descriptor instead.
+func (*StartJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartJobResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CheckoutFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files *FileMap `protobuf:"bytes,1,opt,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *CheckoutFilesRequest) Reset() {
+	*x = CheckoutFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckoutFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutFilesRequest) ProtoMessage() {}
+
+func (x *CheckoutFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutFilesRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutFilesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckoutFilesRequest) GetFiles() *FileMap {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ExportRepositoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+}
+
+func (x *ExportRepositoryRequest) Reset() {
+	*x = ExportRepositoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRepositoryRequest) ProtoMessage() {}
+
+func (x *ExportRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*ExportRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportRepositoryRequest) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+// ListTestsRequest is posted to /listTests to list the tests of a package
+// built with options, as a job with the same options would build them
+type ListTestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg     string       `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Options *TestOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ListTestsRequest) Reset() {
+	*x = ListTestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestsRequest) ProtoMessage() {}
+
+func (x *ListTestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestsRequest.ProtoReflect.Descriptor instead.
+func (*ListTestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTestsRequest) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *ListTestsRequest) GetOptions() *TestOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type JobStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Complete  bool         `protobuf:"varint,1,opt,name=complete,proto3" json:"complete,omitempty"`
+	Details   string       `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	Error     string       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Results   *JobResults  `protobuf:"bytes,4,opt,name=results,proto3" json:"results,omitempty"`
+	Cancelled bool         `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Progress  *JobProgress `protobuf:"bytes,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Metadata  *JobMetadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *JobStatusResponse) Reset() {
+	*x = JobStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatusResponse) ProtoMessage() {}
+
+func (x *JobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use JobStatusResponse.ProtoReflect.Descriptor instead.
 func (*JobStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JobStatusResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *JobStatusResponse) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *JobStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobStatusResponse) GetResults() *JobResults {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *JobStatusResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *JobStatusResponse) GetProgress() *JobProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *JobStatusResponse) GetMetadata() *JobMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// JobMetadata describes what a job was started with and when
+type JobMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pkg   string                   `protobuf:"bytes,1,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	Sort  StartJobRequest_SortType `protobuf:"varint,2,opt,name=sort,proto3,enum=StartJobRequest_SortType" json:"sort,omitempty"`
+	Tests []string                 `protobuf:"bytes,3,rep,name=tests,proto3" json:"tests,omitempty"`
+	// unix times in milliseconds. finished_at_ms is 0 until the job finishes
+	CreatedAtMs  int64 `protobuf:"varint,4,opt,name=created_at_ms,json=createdAtMs,proto3" json:"created_at_ms,omitempty"`
+	FinishedAtMs int64 `protobuf:"varint,5,opt,name=finished_at_ms,json=finishedAtMs,proto3" json:"finished_at_ms,omitempty"`
+	// time taken to run the job so far in milliseconds
+	DurationMs int64 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *JobMetadata) Reset() {
+	*x = JobMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobMetadata) ProtoMessage() {}
+
+func (x *JobMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobMetadata.ProtoReflect.Descriptor instead.
+func (*JobMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JobMetadata) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *JobMetadata) GetSort() StartJobRequest_SortType {
+	if x != nil {
+		return x.Sort
+	}
+	return StartJobRequest_HARDCODED
+}
+
+func (x *JobMetadata) GetTests() []string {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *JobMetadata) GetCreatedAtMs() int64 {
+	if x != nil {
+		return x.CreatedAtMs
+	}
+	return 0
+}
+
+func (x *JobMetadata) GetFinishedAtMs() int64 {
+	if x != nil {
+		return x.FinishedAtMs
+	}
+	return 0
+}
+
+func (x *JobMetadata) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// JobSummary describes a job without its results, for listing jobs
+type JobSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State    JobSummary_State `protobuf:"varint,2,opt,name=state,proto3,enum=JobSummary_State" json:"state,omitempty"`
+	Details  string           `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	Error    string           `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Metadata *JobMetadata     `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *JobSummary) Reset() {
+	*x = JobSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSummary) ProtoMessage() {}
+
+func (x *JobSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSummary.ProtoReflect.Descriptor instead.
+func (*JobSummary) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JobSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobSummary) GetState() JobSummary_State {
+	if x != nil {
+		return x.State
+	}
+	return JobSummary_RUNNING
+}
+
+func (x *JobSummary) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *JobSummary) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JobSummary) GetMetadata() *JobMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// jobs ordered from the most recently created
+	Jobs []*JobSummary `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListJobsResponse) GetJobs() []*JobSummary {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type JobProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase      JobProgress_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=JobProgress_Phase" json:"phase,omitempty"`
+	TestsDone  int32             `protobuf:"varint,2,opt,name=tests_done,json=testsDone,proto3" json:"tests_done,omitempty"`
+	TestsTotal int32             `protobuf:"varint,3,opt,name=tests_total,json=testsTotal,proto3" json:"tests_total,omitempty"`
+	StepsDone  int32             `protobuf:"varint,4,opt,name=steps_done,json=stepsDone,proto3" json:"steps_done,omitempty"`
+	StepsTotal int32             `protobuf:"varint,5,opt,name=steps_total,json=stepsTotal,proto3" json:"steps_total,omitempty"`
+	// time spent in each phase so far in milliseconds, by phase name
+	PhaseElapsedMs map[string]int64 `protobuf:"bytes,6,rep,name=phase_elapsed_ms,json=phaseElapsedMs,proto3" json:"phase_elapsed_ms,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// estimated time in milliseconds until the tests or steps currently being
+	// processed are finished, or 0 if there is no estimate yet
+	EtaMs int64 `protobuf:"varint,7,opt,name=eta_ms,json=etaMs,proto3" json:"eta_ms,omitempty"`
+}
+
+func (x *JobProgress) Reset() {
+	*x = JobProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobProgress) ProtoMessage() {}
+
+func (x *JobProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobProgress.ProtoReflect.Descriptor instead.
+func (*JobProgress) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *JobProgress) GetPhase() JobProgress_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return JobProgress_INITIALIZING
+}
+
+func (x *JobProgress) GetTestsDone() int32 {
+	if x != nil {
+		return x.TestsDone
+	}
+	return 0
+}
+
+func (x *JobProgress) GetTestsTotal() int32 {
+	if x != nil {
+		return x.TestsTotal
+	}
+	return 0
+}
+
+func (x *JobProgress) GetStepsDone() int32 {
+	if x != nil {
+		return x.StepsDone
+	}
+	return 0
+}
+
+func (x *JobProgress) GetStepsTotal() int32 {
+	if x != nil {
+		return x.StepsTotal
+	}
+	return 0
+}
+
+func (x *JobProgress) GetPhaseElapsedMs() map[string]int64 {
+	if x != nil {
+		return x.PhaseElapsedMs
+	}
+	return nil
+}
+
+func (x *JobProgress) GetEtaMs() int64 {
+	if x != nil {
+		return x.EtaMs
+	}
+	return 0
+}
+
+type JobResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tests []string    `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
+	Files []*FileMap  `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Diffs []*StepDiff `protobuf:"bytes,3,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	// kind of function each test is, in the same order as tests
+	Kinds []TestKind `protobuf:"varint,4,rep,packed,name=kinds,proto3,enum=TestKind" json:"kinds,omitempty"`
+	// how running each test of the job ended, including tests left out of
+	// the log, in the order the tests were given
+	TestResults []*TestResult `protobuf:"bytes,5,rep,name=test_results,json=testResults,proto3" json:"test_results,omitempty"`
+	// tests the sort left out of the log because the tests in it already
+	// cover everything they do, in the order the tests were given
+	RedundantTests []string `protobuf:"bytes,6,rep,name=redundant_tests,json=redundantTests,proto3" json:"redundant_tests,omitempty"`
+	// tests of the log whose coverage contains the coverage of earlier tests,
+	// in the order of the log, for CONTAINMENT sorts
+	Refinements []*TestRefinement `protobuf:"bytes,7,rep,name=refinements,proto3" json:"refinements,omitempty"`
+	// the chapters of the log, in order, for CHAPTERS sorts
+	Chapters []*Chapter `protobuf:"bytes,8,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	// what each test of the log adds at its step, in the order of the log
+	Metrics []*TestMetrics `protobuf:"bytes,9,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *JobResults) Reset() {
+	*x = JobResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResults) ProtoMessage() {}
+
+func (x *JobResults) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResults.ProtoReflect.Descriptor instead.
+func (*JobResults) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *JobResults) GetTests() []string {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+func (x *JobResults) GetFiles() []*FileMap {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *JobResults) GetDiffs() []*StepDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *JobResults) GetKinds() []TestKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *JobResults) GetTestResults() []*TestResult {
+	if x != nil {
+		return x.TestResults
+	}
+	return nil
+}
+
+func (x *JobResults) GetRedundantTests() []string {
+	if x != nil {
+		return x.RedundantTests
+	}
+	return nil
+}
+
+func (x *JobResults) GetRefinements() []*TestRefinement {
+	if x != nil {
+		return x.Refinements
+	}
+	return nil
+}
+
+func (x *JobResults) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+func (x *JobResults) GetMetrics() []*TestMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// TestMetrics describes what a test adds at its step of a log
+type TestMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test string `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	// lines the test covers
+	LinesCovered int32 `protobuf:"varint,2,opt,name=lines_covered,json=linesCovered,proto3" json:"lines_covered,omitempty"`
+	// lines the test covers that the steps before it don't
+	NewLines int32 `protobuf:"varint,3,opt,name=new_lines,json=newLines,proto3" json:"new_lines,omitempty"`
+	// lines the test covers that the steps before it do too
+	Overlap int32 `protobuf:"varint,4,opt,name=overlap,proto3" json:"overlap,omitempty"`
+	// average number of tests covering each line the test covers, as ranked
+	// by the IMPORTANCE sort
+	Importance float64 `protobuf:"fixed64,5,opt,name=importance,proto3" json:"importance,omitempty"`
+}
+
+func (x *TestMetrics) Reset() {
+	*x = TestMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestMetrics) ProtoMessage() {}
+
+func (x *TestMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestMetrics.ProtoReflect.Descriptor instead.
+func (*TestMetrics) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TestMetrics) GetTest() string {
+	if x != nil {
+		return x.Test
+	}
+	return ""
+}
+
+func (x *TestMetrics) GetLinesCovered() int32 {
+	if x != nil {
+		return x.LinesCovered
+	}
+	return 0
+}
+
+func (x *TestMetrics) GetNewLines() int32 {
+	if x != nil {
+		return x.NewLines
+	}
+	return 0
+}
+
+func (x *TestMetrics) GetOverlap() int32 {
+	if x != nil {
+		return x.Overlap
+	}
+	return 0
+}
+
+func (x *TestMetrics) GetImportance() float64 {
+	if x != nil {
+		return x.Importance
+	}
+	return 0
+}
+
+// Chapter is a run of steps of a log whose tests cover similar code
+type Chapter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// names the functions the chapter's tests cover most
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the tests of the chapter, in the order of the log
+	Tests []string `protobuf:"bytes,2,rep,name=tests,proto3" json:"tests,omitempty"`
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chapter) GetTests() []string {
+	if x != nil {
+		return x.Tests
+	}
+	return nil
+}
+
+// TestRefinement records that a test covers everything some other tests do,
+// and more
+type TestRefinement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test string `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	// the tests it directly refines, leaving out those refined by another
+	// test in the list
+	Refines []string `protobuf:"bytes,2,rep,name=refines,proto3" json:"refines,omitempty"`
+}
+
+func (x *TestRefinement) Reset() {
+	*x = TestRefinement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestRefinement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRefinement) ProtoMessage() {}
+
+func (x *TestRefinement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRefinement.ProtoReflect.Descriptor instead.
+func (*TestRefinement) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TestRefinement) GetTest() string {
+	if x != nil {
+		return x.Test
+	}
+	return ""
+}
+
+func (x *TestRefinement) GetRefines() []string {
+	if x != nil {
+		return x.Refines
+	}
+	return nil
+}
+
+// TestResult is the outcome of running a single test
+type TestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Test    string      `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	Outcome TestOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=TestOutcome" json:"outcome,omitempty"`
+	// output is what the test printed, as reported by go test -json. Tests
+	// that fail, time out or don't build include everything go test printed
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// how long the test took to run. Sorts only use it to break ties
+	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// the subtests the test ran, in the order they started
+	Subtests []string `protobuf:"bytes,5,rep,name=subtests,proto3" json:"subtests,omitempty"`
+}
+
+func (x *TestResult) Reset() {
+	*x = TestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult) ProtoMessage() {}
+
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TestResult) GetTest() string {
+	if x != nil {
+		return x.Test
+	}
+	return ""
+}
+
+func (x *TestResult) GetOutcome() TestOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TestOutcome_PASS
+}
+
+func (x *TestResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *TestResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TestResult) GetSubtests() []string {
+	if x != nil {
+		return x.Subtests
+	}
+	return nil
+}
+
+// StepDiff describes the changes made to each file by a step of the log,
+// relative to the previous step
+type StepDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*FileDiff `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *StepDiff) Reset() {
+	*x = StepDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepDiff) ProtoMessage() {}
+
+func (x *StepDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepDiff.ProtoReflect.Descriptor instead.
+func (*StepDiff) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StepDiff) GetFiles() []*FileDiff {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FileDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Added   int32  `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	Removed int32  `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	// unified diff hunks, including hunk headers but no file headers
+	Unified string `protobuf:"bytes,4,opt,name=unified,proto3" json:"unified,omitempty"`
+}
+
+func (x *FileDiff) Reset() {
+	*x = FileDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDiff) ProtoMessage() {}
+
+func (x *FileDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDiff.ProtoReflect.Descriptor instead.
+func (*FileDiff) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileDiff) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *FileDiff) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *FileDiff) GetUnified() string {
+	if x != nil {
+		return x.Unified
+	}
+	return ""
+}
+
+// JobEvent is sent on a job's event stream each time the job makes progress
+type JobEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type JobEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=JobEvent_Type" json:"type,omitempty"`
+	// status message, for STATUS events
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// index of the completed step and the test it was built from, for STEP
+	// events. The test is empty for the final step.
+	Step int32     `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	Test string    `protobuf:"bytes,4,opt,name=test,proto3" json:"test,omitempty"`
+	Diff *StepDiff `protobuf:"bytes,5,opt,name=diff,proto3" json:"diff,omitempty"`
+	// final status of the job, for DONE events
+	Status *JobStatusResponse `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// progress of the job, for STATUS events
+	Progress *JobProgress `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	// kind of function the test is, for STEP events
+	Kind TestKind `protobuf:"varint,8,opt,name=kind,proto3,enum=TestKind" json:"kind,omitempty"`
+}
+
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEvent) ProtoMessage() {}
+
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JobEvent) GetType() JobEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return JobEvent_STATUS
+}
+
+func (x *JobEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobEvent) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *JobEvent) GetTest() string {
+	if x != nil {
+		return x.Test
+	}
+	return ""
+}
+
+func (x *JobEvent) GetDiff() *StepDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *JobEvent) GetStatus() *JobStatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *JobEvent) GetProgress() *JobProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *JobEvent) GetKind() TestKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TestKind_TEST
+}
+
+type FileMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files map[string][]byte `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *JobStatusResponse) GetComplete() bool {
-	if x != nil {
-		return x.Complete
+func (x *FileMap) Reset() {
+	*x = FileMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *JobStatusResponse) GetDetails() string {
-	if x != nil {
-		return x.Details
-	}
-	return ""
+func (x *FileMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JobStatusResponse) GetError() string {
-	if x != nil {
-		return x.Error
+func (*FileMap) ProtoMessage() {}
+
+func (x *FileMap) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *JobStatusResponse) GetResults() *JobResults {
+// Deprecated: Use FileMap.ProtoReflect.Descriptor instead.
+func (*FileMap) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FileMap) GetFiles() map[string][]byte {
 	if x != nil {
-		return x.Results
+		return x.Files
 	}
 	return nil
 }
 
-type JobResults struct {
+// Pin fixes the step of a test
+type SortConstraints_Pin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tests []string   `protobuf:"bytes,1,rep,name=tests,proto3" json:"tests,omitempty"`
-	Files []*FileMap `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Test string `protobuf:"bytes,1,opt,name=test,proto3" json:"test,omitempty"`
+	// the step of the log the test is, starting from 1
+	Step int32 `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
 }
 
-func (x *JobResults) Reset() {
-	*x = JobResults{}
+func (x *SortConstraints_Pin) Reset() {
+	*x = SortConstraints_Pin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[4]
+		mi := &file_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobResults) String() string {
+func (x *SortConstraints_Pin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResults) ProtoMessage() {}
+func (*SortConstraints_Pin) ProtoMessage() {}
 
-func (x *JobResults) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[4]
+func (x *SortConstraints_Pin) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,50 +2041,52 @@ func (x *JobResults) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResults.ProtoReflect.Descriptor instead.
-func (*JobResults) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SortConstraints_Pin.ProtoReflect.Descriptor instead.
+func (*SortConstraints_Pin) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *JobResults) GetTests() []string {
+func (x *SortConstraints_Pin) GetTest() string {
 	if x != nil {
-		return x.Tests
+		return x.Test
 	}
-	return nil
+	return ""
 }
 
-func (x *JobResults) GetFiles() []*FileMap {
+func (x *SortConstraints_Pin) GetStep() int32 {
 	if x != nil {
-		return x.Files
+		return x.Step
 	}
-	return nil
+	return 0
 }
 
-type FileMap struct {
+// Order requires a test to come before another
+type SortConstraints_Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files map[string][]byte `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Before string `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (x *FileMap) Reset() {
-	*x = FileMap{}
+func (x *SortConstraints_Order) Reset() {
+	*x = SortConstraints_Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[5]
+		mi := &file_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileMap) String() string {
+func (x *SortConstraints_Order) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMap) ProtoMessage() {}
+func (*SortConstraints_Order) ProtoMessage() {}
 
-func (x *FileMap) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[5]
+func (x *SortConstraints_Order) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,59 +2097,276 @@ func (x *FileMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMap.ProtoReflect.Descriptor instead.
-func (*FileMap) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SortConstraints_Order.ProtoReflect.Descriptor instead.
+func (*SortConstraints_Order) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *FileMap) GetFiles() map[string][]byte {
+func (x *SortConstraints_Order) GetBefore() string {
 	if x != nil {
-		return x.Files
+		return x.Before
 	}
-	return nil
+	return ""
+}
+
+func (x *SortConstraints_Order) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
 }
 
 var File_api_proto protoreflect.FileDescriptor
 
 var file_api_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x01, 0x0a, 0x0f,
+	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x03, 0x0a, 0x0f,
 	0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
 	0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x3b, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x41, 0x52, 0x44, 0x43, 0x4f, 0x44, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45,
-	0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x41, 0x4e, 0x43,
-	0x45, 0x10, 0x03, 0x22, 0x22, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22,
-	0x86, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x25, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52,
-	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x07,
-	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x70,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x5a, 0x04,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45,
+	0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x08, 0x53, 0x6f,
+	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x41, 0x52, 0x44, 0x43, 0x4f,
+	0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x55, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e,
+	0x43, 0x59, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x41, 0x4c, 0x10,
+	0x06, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4c, 0x4c, 0x47, 0x52, 0x41, 0x50, 0x48, 0x10, 0x07,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x08, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x41, 0x50, 0x54, 0x45, 0x52, 0x53, 0x10, 0x09, 0x22,
+	0x42, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x0d, 0x0a, 0x09, 0x46, 0x41, 0x49, 0x4c, 0x5f, 0x46, 0x41, 0x53, 0x54, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41,
+	0x4c, 0x10, 0x02, 0x22, 0xfb, 0x01, 0x0a, 0x0f, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x2e, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x2d, 0x0a, 0x03, 0x50,
+	0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x1a, 0x35, 0x0a, 0x05, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x22, 0x9a, 0x02, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6b, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6b, 0x67, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22,
+	0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x36, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4d, 0x61, 0x70, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x17, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x22, 0x4c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x26, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x11, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xcf, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70,
+	0x6b, 0x67, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x4d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4a,
+	0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0x33, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xd7, 0x03, 0x0a, 0x0b, 0x4a, 0x6f, 0x62,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x73, 0x44, 0x6f, 0x6e,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x73, 0x44, 0x6f, 0x6e,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x10, 0x70, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4a,
+	0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x45, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x65, 0x74, 0x61, 0x4d, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x68, 0x61, 0x73, 0x65, 0x45, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x4d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x78, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x55, 0x49,
+	0x4c, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x52, 0x55, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x49, 0x4e, 0x47,
+	0x10, 0x05, 0x22, 0xde, 0x02, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x70,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x64,
+	0x75, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x35, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x0e, 0x54, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0a, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x65, 0x73, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x08, 0x53, 0x74,
+	0x65, 0x70, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x44,
+	0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x22, 0xac, 0x02, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4a,
+	0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x28, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x4a, 0x6f, 0x62, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x54, 0x45, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02,
+	0x22, 0x6e, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x2a, 0x49, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x50, 0x41, 0x53, 0x53, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x49,
+	0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0b,
+	0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x2a, 0x2b, 0x0a, 0x08, 0x54,
+	0x65, 0x73, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x46, 0x55, 0x5a, 0x5a, 0x10, 0x02, 0x42, 0x06, 0x5a, 0x04, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -457,29 +2381,82 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_api_proto_goTypes = []interface{}{
-	(StartJobRequest_SortType)(0), // 0: StartJobRequest.SortType
-	(*StartJobRequest)(nil),       // 1: StartJobRequest
-	(*StartJobResponse)(nil),      // 2: StartJobResponse
-	(*CheckoutFilesRequest)(nil),  // 3: CheckoutFilesRequest
-	(*JobStatusResponse)(nil),     // 4: JobStatusResponse
-	(*JobResults)(nil),            // 5: JobResults
-	(*FileMap)(nil),               // 6: FileMap
-	nil,                           // 7: FileMap.FilesEntry
+	(TestOutcome)(0),                   // 0: TestOutcome
+	(TestKind)(0),                      // 1: TestKind
+	(StartJobRequest_SortType)(0),      // 2: StartJobRequest.SortType
+	(StartJobRequest_FailurePolicy)(0), // 3: StartJobRequest.FailurePolicy
+	(JobSummary_State)(0),              // 4: JobSummary.State
+	(JobProgress_Phase)(0),             // 5: JobProgress.Phase
+	(JobEvent_Type)(0),                 // 6: JobEvent.Type
+	(*StartJobRequest)(nil),            // 7: StartJobRequest
+	(*SortConstraints)(nil),            // 8: SortConstraints
+	(*TestOptions)(nil),                // 9: TestOptions
+	(*StartJobResponse)(nil),           // 10: StartJobResponse
+	(*CheckoutFilesRequest)(nil),       // 11: CheckoutFilesRequest
+	(*ExportRepositoryRequest)(nil),    // 12: ExportRepositoryRequest
+	(*ListTestsRequest)(nil),           // 13: ListTestsRequest
+	(*JobStatusResponse)(nil),          // 14: JobStatusResponse
+	(*JobMetadata)(nil),                // 15: JobMetadata
+	(*JobSummary)(nil),                 // 16: JobSummary
+	(*ListJobsResponse)(nil),           // 17: ListJobsResponse
+	(*JobProgress)(nil),                // 18: JobProgress
+	(*JobResults)(nil),                 // 19: JobResults
+	(*TestMetrics)(nil),                // 20: TestMetrics
+	(*Chapter)(nil),                    // 21: Chapter
+	(*TestRefinement)(nil),             // 22: TestRefinement
+	(*TestResult)(nil),                 // 23: TestResult
+	(*StepDiff)(nil),                   // 24: StepDiff
+	(*FileDiff)(nil),                   // 25: FileDiff
+	(*JobEvent)(nil),                   // 26: JobEvent
+	(*FileMap)(nil),                    // 27: FileMap
+	(*SortConstraints_Pin)(nil),        // 28: SortConstraints.Pin
+	(*SortConstraints_Order)(nil),      // 29: SortConstraints.Order
+	nil,                                // 30: TestOptions.EnvEntry
+	nil,                                // 31: JobProgress.PhaseElapsedMsEntry
+	nil,                                // 32: FileMap.FilesEntry
 }
 var file_api_proto_depIdxs = []int32{
-	0, // 0: StartJobRequest.sort:type_name -> StartJobRequest.SortType
-	6, // 1: CheckoutFilesRequest.files:type_name -> FileMap
-	5, // 2: JobStatusResponse.results:type_name -> JobResults
-	6, // 3: JobResults.files:type_name -> FileMap
-	7, // 4: FileMap.files:type_name -> FileMap.FilesEntry
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2,  // 0: StartJobRequest.sort:type_name -> StartJobRequest.SortType
+	9,  // 1: StartJobRequest.options:type_name -> TestOptions
+	3,  // 2: StartJobRequest.failure_policy:type_name -> StartJobRequest.FailurePolicy
+	8,  // 3: StartJobRequest.constraints:type_name -> SortConstraints
+	28, // 4: SortConstraints.pins:type_name -> SortConstraints.Pin
+	29, // 5: SortConstraints.orders:type_name -> SortConstraints.Order
+	30, // 6: TestOptions.env:type_name -> TestOptions.EnvEntry
+	27, // 7: CheckoutFilesRequest.files:type_name -> FileMap
+	9,  // 8: ListTestsRequest.options:type_name -> TestOptions
+	19, // 9: JobStatusResponse.results:type_name -> JobResults
+	18, // 10: JobStatusResponse.progress:type_name -> JobProgress
+	15, // 11: JobStatusResponse.metadata:type_name -> JobMetadata
+	2,  // 12: JobMetadata.sort:type_name -> StartJobRequest.SortType
+	4,  // 13: JobSummary.state:type_name -> JobSummary.State
+	15, // 14: JobSummary.metadata:type_name -> JobMetadata
+	16, // 15: ListJobsResponse.jobs:type_name -> JobSummary
+	5,  // 16: JobProgress.phase:type_name -> JobProgress.Phase
+	31, // 17: JobProgress.phase_elapsed_ms:type_name -> JobProgress.PhaseElapsedMsEntry
+	27, // 18: JobResults.files:type_name -> FileMap
+	24, // 19: JobResults.diffs:type_name -> StepDiff
+	1,  // 20: JobResults.kinds:type_name -> TestKind
+	23, // 21: JobResults.test_results:type_name -> TestResult
+	22, // 22: JobResults.refinements:type_name -> TestRefinement
+	21, // 23: JobResults.chapters:type_name -> Chapter
+	20, // 24: JobResults.metrics:type_name -> TestMetrics
+	0,  // 25: TestResult.outcome:type_name -> TestOutcome
+	25, // 26: StepDiff.files:type_name -> FileDiff
+	6,  // 27: JobEvent.type:type_name -> JobEvent.Type
+	24, // 28: JobEvent.diff:type_name -> StepDiff
+	14, // 29: JobEvent.status:type_name -> JobStatusResponse
+	18, // 30: JobEvent.progress:type_name -> JobProgress
+	1,  // 31: JobEvent.kind:type_name -> TestKind
+	32, // 32: FileMap.files:type_name -> FileMap.FilesEntry
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -501,7 +2478,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartJobResponse); i {
+			switch v := v.(*SortConstraints); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -513,7 +2490,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckoutFilesRequest); i {
+			switch v := v.(*TestOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -525,7 +2502,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobStatusResponse); i {
+			switch v := v.(*StartJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -537,7 +2514,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobResults); i {
+			switch v := v.(*CheckoutFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -549,6 +2526,186 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRepositoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chapter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestRefinement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileMap); i {
 			case 0:
 				return &v.state
@@ -560,14 +2717,38 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortConstraints_Pin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortConstraints_Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      7,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,85 +2,333 @@ package commitlog
 
 import (
 	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 	"sync"
-	"sync/atomic"
+	"time"
+
+	"commitlog/api"
 
 	"github.com/dave/dst/decorator"
 	"github.com/google/uuid"
 	"golang.org/x/tools/cover"
+	"google.golang.org/protobuf/proto"
 )
 
 type commitlogApp struct {
 	testRunner testRunner
 	testCoverageCache cache
 	jobCache          cache
+	// jobSummaries holds the summary of every job in jobCache, by job id,
+	// so that jobs can be listed without reading their results
+	jobSummaries cache
+
+	// cancelFuncs holds the functions used to cancel running jobs, and
+	// eventLogs the progress events of every running job, by job id. Both
+	// are guarded by jobsMu
+	cancelFuncs map[string]context.CancelFunc
+	eventLogs   map[string]*eventLog
+	// fingerprints holds the id of the latest job started for each job
+	// fingerprint, and is also guarded by jobsMu. It is filled in from the
+	// job summaries when the app is created
+	fingerprints map[string]string
+	jobsMu       sync.Mutex
 }
 
 type testRunner interface {
 	// GetCoverage returns a go-style coverage profile given an identifier
-	// for a package and test to run
-	GetCoverage(pkg, test string) ([]*cover.Profile, error)
+	// for a package and test to run, and the options to run it with, along
+	// with how the test ended. Tests that don't pass report it in the
+	// result, which is nil if the test passed, with whatever coverage they
+	// collected. An error means the test couldn't be run. Running the test
+	// should be abandoned if ctx is done before it completes.
+	GetCoverage(ctx context.Context, pkg, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error)
+}
+
+// testCompiler is implemented by test runners that can build the tests of a
+// package once and reuse the build for every test they run in a job
+type testCompiler interface {
+	// CompileTests makes GetCoverage reuse a single build of the tests of
+	// pkg with opts until the returned function is called
+	CompileTests(pkg string, opts *api.TestOptions) (release func())
+}
+
+// sourceHasher is implemented by test runners that can detect changes to the
+// source of a package. Identical jobs are only deduplicated when the test
+// runner implements it.
+type sourceHasher interface {
+	// SourceHash returns a hash that changes whenever the source or tests
+	// of the package change, or the source of a package matched by
+	// coverPkgs changes
+	SourceHash(pkg string, coverPkgs []string) (string, error)
 }
 
 type JobConfig struct {
-	pkg   string
-	tests []string
-	sort  testSortingFunction
+	pkg           string
+	tests         []string
+	sort          testSortingFunction
+	sortType      api.StartJobRequest_SortType
+	options       *api.TestOptions
+	failurePolicy api.StartJobRequest_FailurePolicy
+	constraints   *api.SortConstraints
+}
+
+// NewJobConfig creates a JobConfig for the tests of a package, ordered
+// according to the given sort type. The tests are used as the order
+// for the HARDCODED sort type, and are run with opts, which may be nil. Tests
+// that don't pass are handled according to policy. Other sort types order
+// the tests around constraints, which may be nil.
+func NewJobConfig(pkg string, tests []string, sortType api.StartJobRequest_SortType, opts *api.TestOptions, policy api.StartJobRequest_FailurePolicy, constraints *api.SortConstraints) JobConfig {
+	var sortFunc testSortingFunction
+	switch sortType {
+	case api.StartJobRequest_RAW:
+		sortFunc = sortTestsByRawLinesCovered
+	case api.StartJobRequest_NET:
+		sortFunc = sortTestsByNewLinesCovered
+	case api.StartJobRequest_IMPORTANCE:
+		sortFunc = sortTestsByImportance
+	case api.StartJobRequest_DURATION:
+		sortFunc = sortTestsByDuration
+	case api.StartJobRequest_FREQUENCY:
+		sortFunc = sortTestsByFrequency
+		// Weighting by frequency needs hit counts
+		if opts.GetCoverMode() == "" {
+			opts = withCoverMode(opts, "count")
+		}
+	case api.StartJobRequest_MINIMAL:
+		sortFunc = sortTestsByMinimalCover
+	case api.StartJobRequest_CALLGRAPH:
+		sortFunc = sortTestsByCallGraph(pkg)
+	case api.StartJobRequest_CONTAINMENT:
+		// Examples come as early as the tests they refine allow instead
+		sortFunc = sortTestsByContainment
+	case api.StartJobRequest_CHAPTERS:
+		// Examples come first in each chapter instead
+		sortFunc = sortTestsByChapter(pkg)
+	case api.StartJobRequest_HARDCODED:
+		sortFunc = sortHardcodedOrder(tests)
+	}
+	if sortType != api.StartJobRequest_HARDCODED && sortType != api.StartJobRequest_CONTAINMENT && sortType != api.StartJobRequest_CHAPTERS {
+		sortFunc = sortExamplesFirst(sortFunc)
+	}
+	if sortType == api.StartJobRequest_HARDCODED {
+		constraints = nil
+	}
+	if constraints != nil {
+		sortFunc = sortWithConstraints(sortFunc, constraints)
+	}
+	sortFunc = sortWithMetrics(sortFunc)
+
+	return JobConfig{
+		pkg:      pkg,
+		tests:    tests,
+		sort:     sortFunc,
+		sortType:      sortType,
+		options:       opts,
+		failurePolicy: policy,
+		constraints:   constraints,
+	}
+}
+
+// withCoverMode returns a copy of opts, which may be nil, with the given
+// cover mode
+func withCoverMode(opts *api.TestOptions, mode string) *api.TestOptions {
+	out := &api.TestOptions{}
+	if opts != nil {
+		out = proto.Clone(opts).(*api.TestOptions)
+	}
+	out.CoverMode = mode
+	return out
+}
+
+// ValidateTestOptions checks that opts can be passed on to go test
+func ValidateTestOptions(opts *api.TestOptions) error {
+	if opts.GetTimeout() != "" {
+		if _, err := time.ParseDuration(opts.GetTimeout()); err != nil {
+			return fmt.Errorf("invalid timeout: %w", err)
+		}
+	}
+	if opts.GetCount() < 0 {
+		return fmt.Errorf("invalid count %d: must not be negative", opts.GetCount())
+	}
+	switch opts.GetCoverMode() {
+	case "", "set", "count", "atomic":
+	default:
+		return fmt.Errorf("invalid cover mode %q: must be set, count or atomic", opts.GetCoverMode())
+	}
+	for k := range opts.GetEnv() {
+		if k == "" || strings.ContainsAny(k, "=\x00") {
+			return fmt.Errorf("invalid environment variable name %q", k)
+		}
+	}
+	return nil
 }
 
 type jobResult struct {
 	Tests []string
 	Files []map[string][]byte
+	Diffs [][]fileDiff
+	// Outcomes holds how running each test of the job ended, including
+	// tests left out of the log, in the order of the job's tests
+	Outcomes []testOutcome
 }
 
 type cache interface {
 	Read(key string) interface{}
 	Write(key string, value interface{})
 	Delete(key string)
+	// Keys lists the keys of every entry, in no particular order
+	Keys() []string
+}
+
+func init() {
+	// Allow job cache entries and their summaries to be stored in a
+	// persistent cache
+	gob.Register(jobCacheEntry{})
+	gob.Register(jobSummary{})
 }
 
 type jobCacheEntry struct {
-	Complete bool
-	Details  string
+	Complete  bool
+	Cancelled bool
+	// Details is the latest progress message, kept alongside Progress
+	// for clients that display it directly
+	Details   string
+	// Progress is only set while the job is running
+	Progress  *jobProgress
 	Error    string
 	Results  jobResult
+	Metadata jobMetadata
 }
 
-func NewCommitLogApp(runner testRunner, testCoverageCache cache, jobCache cache) *commitlogApp {
-	return &commitlogApp{
+func NewCommitLogApp(runner testRunner, testCoverageCache cache, jobCache cache, jobSummaries cache) *commitlogApp {
+	app := &commitlogApp{
 		testRunner: runner,
 		testCoverageCache: testCoverageCache,
 		jobCache:          jobCache,
+		jobSummaries:      jobSummaries,
+		cancelFuncs:       map[string]context.CancelFunc{},
+		eventLogs:         map[string]*eventLog{},
+		fingerprints:      map[string]string{},
 	}
+	app.summarizeJobs()
+	app.loadFingerprints()
+	return app
 }
 
+// StartJob starts computing the log for conf, and returns the id of the job.
+// If an identical job for the same package source is running or complete,
+// the id of that job is returned instead.
 func (c *commitlogApp) StartJob(conf JobConfig) string {
+	fingerprint := c.jobFingerprint(conf)
+
+	c.jobsMu.Lock()
+	if id, ok := c.duplicateJobLocked(fingerprint); ok {
+		c.jobsMu.Unlock()
+		return id
+	}
 	id := uuid.New()
-	c.jobCache.Write(id.String(), jobCacheEntry{
+	ctx, cancel := context.WithCancel(context.Background())
+	events := newEventLog()
+	c.cancelFuncs[id.String()] = cancel
+	c.eventLogs[id.String()] = events
+	if fingerprint != "" {
+		c.fingerprints[fingerprint] = id.String()
+	}
+	c.jobsMu.Unlock()
+
+	meta := newJobMetadata(conf, time.Now())
+	meta.Fingerprint = fingerprint
+	c.writeJob(id.String(), jobCacheEntry{
 		Complete: false,
 		Details:  "Initializing job",
+		Metadata: meta,
 	})
 
 	go func() {
-		results, err := c.doJobOperation(id.String(), conf)
-		if err != nil {
-			c.jobCache.Write(id.String(), jobCacheEntry{
+		defer func() {
+			c.jobsMu.Lock()
+			delete(c.cancelFuncs, id.String())
+			c.jobsMu.Unlock()
+			cancel()
+		}()
+
+		var entry jobCacheEntry
+		results, err := c.doJobOperation(ctx, id.String(), conf, meta, events)
+		if ctx.Err() == context.Canceled {
+			entry = jobCacheEntry{
+				Cancelled: true,
+				Error:     "job cancelled",
+			}
+		} else if err != nil {
+			entry = jobCacheEntry{
 				Error:    err.Error(),
-			})
+			}
 		} else {
-			c.jobCache.Write(id.String(), jobCacheEntry{
+			entry = jobCacheEntry{
 				Complete: true,
 				Results:  results,
-			})
+			}
 		}
+		entry.Metadata = meta
+		entry.Metadata.Finished = time.Now()
+		c.writeJob(id.String(), entry)
+
+		// Subscribers following the job hold on to its event log until they
+		// receive the DONE event, and later ones are sent the final status
+		// from the job cache, so the log is dropped once the job finishes
+		c.jobsMu.Lock()
+		delete(c.eventLogs, id.String())
+		c.jobsMu.Unlock()
+		events.add(jobEvent{Type: api.JobEvent_DONE, Status: &entry})
 	}()
 
 	return id.String()
 }
 
+// CancelJob stops the running job with the given id, terminating any tests
+// it is running. It returns false if there is no running job with the id.
+func (c *commitlogApp) CancelJob(id string) bool {
+	c.jobsMu.Lock()
+	cancel, ok := c.cancelFuncs[id]
+	c.jobsMu.Unlock()
+	if !ok {
+		return false
+	}
+
+	cancel()
+	return true
+}
+
+// JobEvents returns a channel that receives every progress event of the job
+// with the given id, starting from the beginning of the job. The channel is
+// closed after the job finishes or when ctx is done. It returns false if there
+// is no job with the id.
+func (c *commitlogApp) JobEvents(ctx context.Context, id string) (<-chan jobEvent, bool) {
+	c.jobsMu.Lock()
+	events, ok := c.eventLogs[id]
+	c.jobsMu.Unlock()
+	if ok {
+		return events.follow(ctx), true
+	}
+
+	// Finished jobs have no event log, the only event left to send is
+	// their final status
+	status, err := c.JobStatus(id)
+	if err != nil || status == nil {
+		return nil, false
+	}
+	out := make(chan jobEvent, 1)
+	out <- jobEvent{Type: api.JobEvent_DONE, Status: status}
+	close(out)
+	return out, true
+}
+
 func (c *commitlogApp) JobStatus(id string) (*jobCacheEntry, error) {
 	info := c.jobCache.Read(id)
 	if info == nil {
@@ -91,47 +339,101 @@ func (c *commitlogApp) JobStatus(id string) (*jobCacheEntry, error) {
 	if !ok {
 		return nil, fmt.Errorf("unexpected type in job cache: %#v", val)
 	}
+
+	// An unfinished job that isn't running was interrupted by the server
+	// stopping, and is never going to finish
+	if c.interrupted(id, val.summary()) {
+		val = jobCacheEntry{
+			Error:    errJobInterrupted,
+			Metadata: val.Metadata,
+		}
+	}
 	return &val, nil
 }
 
-type cacheWriter struct {
-	id string
-	cache
+
+func (c *commitlogApp) doJobOperation(ctx context.Context, id string, conf JobConfig, meta jobMetadata, events *eventLog) (jobResult, error) {
+	onStep := func(step int, test string, diff []fileDiff) {
+		events.add(jobEvent{
+			Type: api.JobEvent_STEP,
+			Step: step,
+			Test: test,
+			Diff: diff,
+		})
+	}
+
+	// Record progress in the job cache and event log
+	progress := newProgressTracker(func(p jobProgress) {
+		c.writeJob(id, jobCacheEntry{
+			Complete: false,
+			Details:  p.Message,
+			Progress: &p,
+			Metadata: meta,
+		})
+		events.add(jobEvent{Type: api.JobEvent_STATUS, Message: p.Message, Progress: p})
+	})
+
+	return c.computeJobResult(ctx, id, conf, progress, onStep)
 }
-func (cw cacheWriter) Write(p []byte) (int, error) {
-	cw.cache.Write(cw.id, jobCacheEntry{
-		Complete: false,
-		Details:  string(p),
+
+// RunJob runs a job to completion without adding it to the job cache,
+// writing progress messages to status as it goes. The job is abandoned if
+// ctx is done before it completes.
+func (c *commitlogApp) RunJob(ctx context.Context, conf JobConfig, status io.Writer) (jobResult, error) {
+	progress := newProgressTracker(func(p jobProgress) {
+		status.Write([]byte(p.Message))
 	})
-	return len(p), nil
+	return c.computeJobResult(ctx, uuid.New().String(), conf, progress, nil)
 }
 
-func (c *commitlogApp) doJobOperation(id string, conf JobConfig) (jobResult, error) {
-	tests, fileContents, err := computeFileContentsByTest(computationConfig{
+// computeJobResult computes the log for conf, calling onStep, if it isn't
+// nil, with the changes each step makes as soon as the step is computed
+func (c *commitlogApp) computeJobResult(ctx context.Context, id string, conf JobConfig, progress *progressTracker, onStep stepDiffCallback) (jobResult, error) {
+	// Each step is diffed once, as soon as it's computed
+	var differ stepDiffer
+	addStep := func(step int, test string, files map[string][]byte) {
+		diff := differ.add(files)
+		if onStep != nil {
+			onStep(step, test, diff)
+		}
+	}
+
+	tests, fileContents, outcomes, err := computeFileContentsByTest(computationConfig{
+		ctx:   ctx,
 		uuid:  id,
 		testCoverageCache: c.testCoverageCache,
-		statusWriter: cacheWriter{id: id, cache: c.jobCache},
+		progress: progress,
+		onStep: addStep,
 		runner: c.testRunner,
-		JobConfig: JobConfig{
-			pkg:   conf.pkg,
-			tests: conf.tests,
-			sort:  conf.sort,
-		},
+		JobConfig: conf,
 	})
 	if err != nil {
 		return jobResult{}, err
 	}
 
 	return jobResult{
-		Tests: tests,
-		Files: fileContents,
+		Tests:    tests,
+		Files:    fileContents,
+		Diffs:    differ.diffs,
+		Outcomes: outcomes,
 	}, nil
 }
 
+// stepCallback is called with the index, test and file contents of each step of
+// a log as soon as it has been computed. The test is empty for the final step.
+type stepCallback func(step int, test string, files map[string][]byte)
+
+// stepDiffCallback is called with the index, test and changes of each step of
+// a log as soon as it has been computed. The test is empty for the final step.
+type stepDiffCallback func(step int, test string, diff []fileDiff)
+
 type computationConfig struct {
+	ctx   context.Context
 	uuid  string
 	testCoverageCache cache
-	statusWriter io.Writer
+	progress *progressTracker
+	// onStep is optional
+	onStep stepCallback
 	runner testRunner
 	JobConfig
 }
@@ -144,104 +446,162 @@ type testCoverageRequest struct {
 type testCoverageResponse struct {
 	test string
 	profiles []*cover.Profile
+	outcome testOutcome
+	// keep is false for tests left out of the log by the failure policy
+	keep bool
 }
 
 
 // computeFileContentsByTest calculates code diffs given a computationConfig.
 // It returns the ordered tests, a map filename -> fileContents for each test, where the content
-// is what is covered by the tests up to that point in the ordering, and an error.
-func computeFileContentsByTest(config computationConfig) ([]string, []map[string][]byte, error) {
+// is what is covered by the tests up to that point in the ordering, how running each test
+// ended, and an error.
+func computeFileContentsByTest(config computationConfig) ([]string, []map[string][]byte, []testOutcome, error) {
 	var (
 		pkg = config.pkg
 		tests = config.tests
 		prevProfiles []*cover.Profile
-		out = make([]map[string][]byte, len(tests)+1)
 		profilesByTest = testProfileData{}
+		outcomesByTest = map[string]testOutcome{}
 		finalContentsMap = map[string][]byte{}
 		wg sync.WaitGroup
-		completedCoverageOps uint64
 		inputs = make(chan testCoverageRequest)
 		results = make(chan testCoverageResponse, len(tests))
-		errors = make(chan error)
+		errors = make(chan error, len(tests))
 	)
 
+	// Once one test fails there's no point running the rest
+	ctx, abort := context.WithCancel(config.ctx)
+	defer abort()
+
 
 	workerCount := 8
 	if len(tests) < workerCount {
 		workerCount = len(tests)
 	}
 
+	if compiler, ok := config.runner.(testCompiler); ok {
+		release := compiler.CompileTests(pkg, config.options)
+		defer release()
+	}
+
+	config.progress.start(len(tests), len(tests))
+	config.progress.startPhase(api.JobProgress_COLLECTING_COVERAGE, fmt.Sprintf("Collecting coverage for %d tests", len(tests)))
+
 	for i:=0;i < workerCount; i++ {
-		go testProfilesWorker(config, inputs, results, errors, &wg, &completedCoverageOps, len(tests))
+		go testProfilesWorker(ctx, abort, config, inputs, results, errors, &wg)
 	}
 
 	for _, test := range tests {
-		inputs <- testCoverageRequest{pkg: pkg, test: test}
 		wg.Add(1)
+		inputs <- testCoverageRequest{pkg: pkg, test: test}
 	}
 
 	close(inputs)
 	wg.Wait()
 	close(results)
 
+	if err := config.ctx.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+
 	select {
 	case err := <-errors:
-		return nil, nil, err
+		return nil, nil, nil, err
 	default:
 	}
 
 	for result := range results {
-		profilesByTest[result.test] = result.profiles
+		outcomesByTest[result.test] = result.outcome
+		if result.keep {
+			profilesByTest[result.test] = result.profiles
+		}
+	}
+	outcomes := make([]testOutcome, len(tests))
+	durations := testDurations{}
+	for i, test := range tests {
+		outcomes[i] = outcomesByTest[test]
+		durations[test] = outcomes[i].Duration
 	}
 
-	config.statusWriter.Write([]byte("Computing test ordering"))
+	config.progress.startPhase(api.JobProgress_SORTING, "Computing test ordering")
 
-	sortedTests := config.sort(profilesByTest)
-	config.statusWriter.Write([]byte(fmt.Sprint("got sorted tests: ", sortedTests)))
+	var sortedTests []string
+	inLog := map[string]bool{}
+	sorted := config.sort(profilesByTest, durations)
+	for _, test := range sorted.Order {
+		// The HARDCODED order includes tests left out of the log
+		if _, ok := profilesByTest[test]; ok {
+			sortedTests = append(sortedTests, test)
+			inLog[test] = true
+		}
+	}
+	// Some sorts leave out tests that add nothing to the others
+	for i, test := range tests {
+		if _, ok := profilesByTest[test]; ok && !inLog[test] {
+			outcomes[i].Redundant = true
+		}
+	}
+	if err := checkConstraints(config.constraints, sortedTests); err != nil {
+		return nil, nil, nil, err
+	}
+	if len(sortedTests) < len(tests) {
+		config.progress.setSteps(len(sortedTests))
+	}
+	for i, test := range tests {
+		if m, ok := sorted.Metrics[test]; ok && inLog[test] {
+			outcomes[i].Metrics = &m
+		}
+		outcomes[i].Refines = sorted.Refines[test]
+		outcomes[i].Chapter = sorted.Chapters[test]
+	}
+	out := make([]map[string][]byte, len(sortedTests)+1)
 
 	for i, test := range sortedTests {
-		config.statusWriter.Write([]byte(fmt.Sprintf("Constructing diff %d of %d", i+1, len(sortedTests))))
+		if err := config.ctx.Err(); err != nil {
+			return nil, nil, nil, err
+		}
+
+		config.progress.startPhase(api.JobProgress_BUILDING_STEP, fmt.Sprintf("Constructing diff %d of %d", i+1, len(sortedTests)))
 		profiles := profilesByTest[test]
 		activeProfiles, _ := mergeProfiles(prevProfiles, profiles)
 
 		contentsMap := map[string][]byte{}
 
-		config.statusWriter.Write( []byte("constructing dsts"))
 		files, fset, ds, err := constructCoveredDSTs(activeProfiles, pkg)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
-		config.statusWriter.Write([]byte("removing dead code"))
+		config.progress.startPhase(api.JobProgress_PRUNING_DEAD_CODE, fmt.Sprintf("Removing dead code from diff %d of %d", i+1, len(sortedTests)))
 		// Parse package and kill dead code
 		undeadFiles, updated, err := removeDeadCode(files, fset, ds)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		for updated {
 			undeadFiles, updated, err = removeDeadCode(files, fset, ds)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 		}
 
-		config.statusWriter.Write([]byte("turning asts into []bytes"))
+		config.progress.startPhase(api.JobProgress_RENDERING, fmt.Sprintf("Rendering diff %d of %d", i+1, len(sortedTests)))
 		// Convert ASTs into []byte
 		for name, tree := range undeadFiles {
 			var buf bytes.Buffer
 			r := decorator.NewRestorer()
 			err = r.Fprint(&buf, tree)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			contentsMap[name] = buf.Bytes()
 
 			if _, ok := finalContentsMap[name]; !ok {
-				config.statusWriter.Write([]byte(fmt.Sprintf("loading final contents for %s", name)))
 				fullFileData, err := ioutil.ReadFile(name)
 				if err != nil {
-					return nil, nil, err
+					return nil, nil, nil, err
 				}
 				finalContentsMap[name] = fullFileData
 			}
@@ -249,12 +609,23 @@ func computeFileContentsByTest(config computationConfig) ([]string, []map[string
 
 		out[i] = contentsMap
 		prevProfiles = activeProfiles
+		config.progress.stepDone()
+		if config.onStep != nil {
+			config.onStep(i, test, contentsMap)
+		}
+	}
+	out[len(sortedTests)] = finalContentsMap
+	if config.onStep != nil {
+		config.onStep(len(sortedTests), "", finalContentsMap)
 	}
-	out[len(tests)] = finalContentsMap
-	return sortedTests, out, nil
+	return sortedTests, out, outcomes, nil
 }
 
-func testProfilesWorker(config computationConfig, inputs chan testCoverageRequest, results chan testCoverageResponse, errors chan<- error, wg *sync.WaitGroup, count *uint64, total int) {
+// testProfilesWorker collects coverage for the tests it receives on inputs until
+// inputs is closed. If collecting coverage for a test fails, or the test fails
+// under the job's failure policy, the error is sent on errors and abort is called
+// so that the remaining tests are skipped.
+func testProfilesWorker(ctx context.Context, abort context.CancelFunc, config computationConfig, inputs chan testCoverageRequest, results chan testCoverageResponse, errors chan<- error, wg *sync.WaitGroup) {
 	for coverageRequest := range inputs {
 		pkg := coverageRequest.pkg
 		test := coverageRequest.test
@@ -262,43 +633,96 @@ func testProfilesWorker(config computationConfig, inputs chan testCoverageReques
 			test: test,
 		}
 
-		profiles, err := getTestProfiles(pkg, test, config.runner, config.testCoverageCache)
-		atomic.AddUint64(count, 1)
-		wg.Done()
-		config.statusWriter.Write([]byte(fmt.Sprintf("Computed coverage for %d of %d tests", *count, total)))
+		profiles, outcome, err := getTestProfiles(ctx, pkg, test, config.options, config.runner, config.testCoverageCache)
+		keep := false
+		if err == nil {
+			keep, err = applyFailurePolicy(config.failurePolicy, outcome)
+		}
+		if err == nil && len(config.options.GetCoverPkgs()) > 0 {
+			// Coverage of other packages includes every file in them, only
+			// the files the test reaches belong in the log
+			profiles = coveredProfiles(profiles)
+		}
 
 		if err != nil {
+			// Send before aborting so that the error that caused the abort is
+			// received ahead of errors from the tests it interrupts
 			errors <- err
-			return
+			abort()
+		} else {
+			config.progress.testDone()
+			resp.profiles = profiles
+			resp.outcome = outcome
+			resp.keep = keep
+			results <- resp
 		}
-
-		resp.profiles = profiles
-		results <- resp
+		wg.Done()
 	}
 }
 
-func getTestProfiles(pkg, test string, runner testRunner, testCache cache) ([]*cover.Profile, error)  {
-	info := testCache.Read(cacheKeyForTest(pkg, test))
+// testCoverageCacheEntry is the coverage of a passing test, and what running
+// it reported
+type testCoverageCacheEntry struct {
+	Profiles []*cover.Profile
+	Outcome  testOutcome
+}
+
+// getTestProfiles returns the coverage of a test and how running it ended, as
+// reported by runner. Only passing tests are cached, so that flaky tests get
+// another chance.
+func getTestProfiles(ctx context.Context, pkg, test string, opts *api.TestOptions, runner testRunner, testCache cache) ([]*cover.Profile, testOutcome, error)  {
+	if err := ctx.Err(); err != nil {
+		return nil, testOutcome{}, err
+	}
+
+	key, err := cacheKeyForTest(pkg, test, opts)
+	if err != nil {
+		return nil, testOutcome{}, err
+	}
+	info := testCache.Read(key)
 	if info != nil {
-	val, ok := info.([]*cover.Profile)
+	val, ok := info.(testCoverageCacheEntry)
 	if !ok {
-	return nil, fmt.Errorf("unexpected type in test cache: %#v", val)
+	return nil, testOutcome{}, fmt.Errorf("unexpected type in test cache: %#v", info)
 	}
 
-	if val != nil {
-	return val, nil
+	if val.Profiles != nil {
+	return val.Profiles, val.Outcome, nil
 	}
 	}
 
-	profiles, err := runner.GetCoverage(pkg, test)
+	profiles, result, err := runner.GetCoverage(ctx, pkg, test, opts)
 	if err != nil {
-	return nil, err
+	return nil, testOutcome{}, err
+	}
+
+	outcome := newTestOutcome(test, result)
+	if outcome.Outcome == api.TestOutcome_PASS {
+		testCache.Write(key, testCoverageCacheEntry{Profiles: profiles, Outcome: outcome})
 	}
+	return profiles, outcome, nil
+}
 
-	testCache.Write(cacheKeyForTest(pkg, test), profiles)
-	return profiles, nil
+// cacheKeyForTest returns the key of the coverage of a test in the test
+// cache. Tests run with options have their own entries.
+func cacheKeyForTest(pkg, test string, opts *api.TestOptions) (string, error) {
+	key := pkg + "-" + test
+	optionsKey, err := testOptionsKey(opts)
+	if err != nil || optionsKey == "" {
+		return key, err
+	}
+	return key + "-" + optionsKey, nil
 }
 
-func cacheKeyForTest(pkg, test string) string {
-	return pkg + "-" + test
+// testOptionsKey returns a string identifying opts, which is empty when no
+// options are set
+func testOptionsKey(opts *api.TestOptions) (string, error) {
+	js, err := json.Marshal(opts)
+	if err != nil {
+		return "", err
+	}
+	if string(js) == "null" || string(js) == "{}" {
+		return "", nil
+	}
+	return string(js), nil
 }
\ No newline at end of file
@@ -1,6 +1,7 @@
 package commitlog
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -8,40 +9,52 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"commitlog/api"
 	memCache "commitlog/cache"
 
 	"golang.org/x/tools/cover"
 )
 
-type mockWriter struct {}
-func (mw mockWriter) Write(p []byte) (int, error) {
-	return len(p), nil
-}
-
 type mockMemRunner struct {}
-func (m mockMemRunner) GetCoverage(pkg string, test string) ([]*cover.Profile, error) {
+func (m mockMemRunner) GetCoverage(ctx context.Context, pkg string, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
 	return []*cover.Profile{
 		{
 			FileName: pkg + "-" + test,
 		},
-	}, nil
+	}, nil, nil
 }
 
 type mockFileRunner struct {}
-func (m mockFileRunner) GetCoverage(pkg string, test string) ([]*cover.Profile, error) {
+func (m mockFileRunner) GetCoverage(ctx context.Context, pkg string, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
 	basePath, err := os.Getwd()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	coverFileName := path.Join(basePath, "testdata", fmt.Sprintf("coverage-%s.out", test))
 	profiles, err := cover.ParseProfiles(coverFileName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return profiles, nil
+	return profiles, nil, nil
+}
+
+// mockBlockingRunner runs tests that never finish unless they're cancelled
+type mockBlockingRunner struct {
+	started chan struct{}
+}
+func (m mockBlockingRunner) GetCoverage(ctx context.Context, pkg string, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
+	m.started <- struct{}{}
+	<-ctx.Done()
+	return nil, nil, ctx.Err()
+}
+
+type mockErrorRunner struct {}
+func (m mockErrorRunner) GetCoverage(ctx context.Context, pkg string, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
+	return nil, nil, fmt.Errorf("failed to run %s", test)
 }
 
 func mockApp() commitlogApp {
@@ -49,6 +62,10 @@ func mockApp() commitlogApp {
 		testRunner:        mockMemRunner{},
 		testCoverageCache: memCache.New(),
 		jobCache:          memCache.New(),
+		jobSummaries:      memCache.New(),
+		cancelFuncs:       map[string]context.CancelFunc{},
+		eventLogs:         map[string]*eventLog{},
+		fingerprints:      map[string]string{},
 	}
 }
 
@@ -63,6 +80,47 @@ func TestStartJob(t *testing.T) {
 	}
 }
 
+func TestNewJobConfig_FrequencyCollectsCounts(t *testing.T) {
+	opts := &api.TestOptions{Tags: []string{"integration"}}
+	conf := NewJobConfig("pkg", nil, api.StartJobRequest_FREQUENCY, opts, api.StartJobRequest_FAIL_FAST, nil)
+	if conf.options.GetCoverMode() != "count" || !reflect.DeepEqual(conf.options.GetTags(), opts.GetTags()) {
+		t.Errorf("expected count mode with the job's options, got %v", conf.options)
+	}
+	if opts.GetCoverMode() != "" {
+		t.Errorf("expected the job's options not to be modified")
+	}
+
+	conf = NewJobConfig("pkg", nil, api.StartJobRequest_FREQUENCY, &api.TestOptions{CoverMode: "atomic"}, api.StartJobRequest_FAIL_FAST, nil)
+	if conf.options.GetCoverMode() != "atomic" {
+		t.Errorf("expected the job's cover mode to be kept, got %q", conf.options.GetCoverMode())
+	}
+}
+
+func TestNewJobConfig_ContainmentExampleRefinesTest(t *testing.T) {
+	lines := func(ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		// ExampleWide refines TestBase, so it has to come after it even
+		// though examples come first. ExampleOther refines nothing and
+		// comes first although it adds the most lines.
+		"TestBase":     lines([2]int{1, 3}),
+		"ExampleWide":  lines([2]int{1, 5}),
+		"TestSmall":    lines([2]int{20, 20}),
+		"ExampleOther": lines([2]int{30, 40}),
+	}
+
+	conf := NewJobConfig("pkg", nil, api.StartJobRequest_CONTAINMENT, nil, api.StartJobRequest_FAIL_FAST, nil)
+	expected := []string{"ExampleOther", "TestSmall", "TestBase", "ExampleWide"}
+	if actual := conf.sort(profiles, nil).Order; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
 func TestComputeFileContentsByTest(t *testing.T) {
 	basePath, err := os.Getwd()
 	if err != nil {
@@ -89,10 +147,11 @@ func TestComputeFileContentsByTest(t *testing.T) {
 	}
 
 	expectedTestOrder := []string{"TestFuncOne", "TestFuncTwo", "TestFuncThree"}
-	tests, files, err := computeFileContentsByTest(computationConfig{
+	tests, files, _, err := computeFileContentsByTest(computationConfig{
+		ctx:               context.Background(),
 		uuid:              "id-1",
 		testCoverageCache: memCache.New(),
-		statusWriter:      mockWriter{},
+		progress:          newProgressTracker(func(jobProgress) {}),
 		runner:            mockFileRunner{},
 		JobConfig:         JobConfig{
 			pkg:   "testdata",
@@ -143,12 +202,12 @@ func TestJobStatus(t *testing.T) {
 func TestGetTestProfiles_FallsBackToTestRunner(t *testing.T) {
 	testCache := memCache.New()
 
-	runnerCoverage, err := mockMemRunner{}.GetCoverage("pkg", "uncached")
+	runnerCoverage, _, err := mockMemRunner{}.GetCoverage(context.Background(), "pkg", "uncached", nil)
 	if err != nil {
 		t.Errorf("unexpect error: %s", err)
 	}
 
-	profiles, err := getTestProfiles("pkg", "uncached", mockMemRunner{}, testCache)
+	profiles, _, err := getTestProfiles(context.Background(), "pkg", "uncached", nil, mockMemRunner{}, testCache)
 	if err != nil {
 		t.Errorf("unexpect error: %s", err)
 	}
@@ -170,9 +229,13 @@ func TestGetTestProfiles_UsesCache(t *testing.T) {
 			FileName: "cache-filename",
 		},
 	}
-	testCache.Write(cacheKeyForTest("pkg", "test1"), cachedProfiles)
+	key, err := cacheKeyForTest("pkg", "test1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCache.Write(key, testCoverageCacheEntry{Profiles: cachedProfiles})
 
-	profiles, err := getTestProfiles("pkg", "test1", mockMemRunner{}, testCache)
+	profiles, _, err := getTestProfiles(context.Background(), "pkg", "test1", nil, mockMemRunner{}, testCache)
 	if err != nil {
 		t.Errorf("unexpect error: %s", err)
 	}
@@ -185,4 +248,242 @@ func TestGetTestProfiles_UsesCache(t *testing.T) {
 			t.Error("Did not use test cache")
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestCancelJob(t *testing.T) {
+	runner := mockBlockingRunner{started: make(chan struct{})}
+	app := mockApp()
+	app.testRunner = runner
+
+	id := app.StartJob(JobConfig{
+		tests: []string{"TestFuncOne"},
+		sort:  sortHardcodedOrder([]string{"TestFuncOne"}),
+	})
+	<-runner.started
+
+	if !app.CancelJob(id) {
+		t.Fatal("expected running job to be cancelled")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, err := app.JobStatus(id)
+		if err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+		if status.Cancelled {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job was not marked as cancelled, status: %#v", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if app.CancelJob(id) {
+		t.Errorf("expected cancelling a finished job to fail")
+	}
+}
+
+func TestComputeFileContentsByTest_RunnerError(t *testing.T) {
+	var tests []string
+	for i := 0; i < 20; i++ {
+		tests = append(tests, fmt.Sprintf("Test%d", i))
+	}
+
+	_, _, _, err := computeFileContentsByTest(computationConfig{
+		ctx:               context.Background(),
+		testCoverageCache: memCache.New(),
+		progress:          newProgressTracker(func(jobProgress) {}),
+		runner:            mockErrorRunner{},
+		JobConfig: JobConfig{
+			tests: tests,
+			sort:  sortHardcodedOrder(tests),
+		},
+	})
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to run") {
+		t.Errorf("expected runner error, got: %v", err)
+	}
+}
+
+func TestJobEvents(t *testing.T) {
+	app := mockApp()
+	app.testRunner = mockFileRunner{}
+
+	tests := []string{"TestFuncOne", "TestFuncTwo"}
+	id := app.StartJob(JobConfig{
+		pkg:   "testdata",
+		tests: tests,
+		sort:  sortHardcodedOrder(tests),
+	})
+
+	events, ok := app.JobEvents(context.Background(), id)
+	if !ok {
+		t.Fatal("expected to find events for started job")
+	}
+
+	var (
+		steps []string
+		last  jobEvent
+	)
+	for e := range events {
+		if e.Type == api.JobEvent_STEP {
+			steps = append(steps, e.Test)
+		}
+		last = e
+	}
+
+	if expectedSteps := []string{"TestFuncOne", "TestFuncTwo", ""}; !reflect.DeepEqual(steps, expectedSteps) {
+		t.Errorf("unexpected step events, got: %#v, expected: %#v", steps, expectedSteps)
+	}
+	if last.Type != api.JobEvent_DONE || !last.Status.Complete {
+		t.Errorf("expected last event to be a completed status, got: %#v", last)
+	}
+
+	if _, ok := app.JobEvents(context.Background(), "missing"); ok {
+		t.Errorf("expected no events for unknown job")
+	}
+}
+
+func TestJobEvents_Finished(t *testing.T) {
+	app := mockApp()
+	app.testRunner = mockFileRunner{}
+
+	tests := []string{"TestFuncOne", "TestFuncTwo"}
+	id := app.StartJob(JobConfig{
+		pkg:   "testdata",
+		tests: tests,
+		sort:  sortHardcodedOrder(tests),
+	})
+	events, _ := app.JobEvents(context.Background(), id)
+	for range events {
+	}
+
+	app.jobsMu.Lock()
+	_, kept := app.eventLogs[id]
+	app.jobsMu.Unlock()
+	if kept {
+		t.Errorf("expected event log to be dropped once the job finished")
+	}
+
+	// Subscribers arriving after the job finished only get its final status
+	events, ok := app.JobEvents(context.Background(), id)
+	if !ok {
+		t.Fatal("expected to find events for finished job")
+	}
+	var received []jobEvent
+	for e := range events {
+		received = append(received, e)
+	}
+	if len(received) != 1 || received[0].Type != api.JobEvent_DONE || !received[0].Status.Complete {
+		t.Fatalf("expected only a completed status event, got: %#v", received)
+	}
+	if !reflect.DeepEqual(received[0].Status.Results.Tests, tests) {
+		t.Errorf("unexpected tests in final status, got: %#v, expected: %#v", received[0].Status.Results.Tests, tests)
+	}
+}
+
+func TestJobStatus_PersistedAcrossRestarts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "commitlog-jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	jobCache, err := memCache.NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	app := mockApp()
+	app.testRunner = mockFileRunner{}
+	app.jobCache = jobCache
+
+	tests := []string{"TestFuncOne", "TestFuncTwo"}
+	id := app.StartJob(JobConfig{
+		pkg:   "testdata",
+		tests: tests,
+		sort:  sortHardcodedOrder(tests),
+	})
+	events, _ := app.JobEvents(context.Background(), id)
+	for range events {
+	}
+
+	// A new app reading the same directory stands in for a restarted server
+	restartedCache, err := memCache.NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted := mockApp()
+	restarted.jobCache = restartedCache
+
+	status, err := restarted.JobStatus(id)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if status == nil || !status.Complete {
+		t.Fatalf("expected job to be complete after restart, got: %#v", status)
+	}
+	if !reflect.DeepEqual(status.Results.Tests, tests) {
+		t.Errorf("unexpected tests after restart, got: %#v, expected: %#v", status.Results.Tests, tests)
+	}
+
+	events, ok := restarted.JobEvents(context.Background(), id)
+	if !ok {
+		t.Fatal("expected to find events for persisted job")
+	}
+	if e := <-events; e.Type != api.JobEvent_DONE || !e.Status.Complete {
+		t.Errorf("expected a completed status event, got: %#v", e)
+	}
+}
+
+func TestJobStatus_Interrupted(t *testing.T) {
+	jobID := "id-1"
+	app := mockApp()
+	app.jobCache = memCache.From(map[string]interface{}{
+		jobID: jobCacheEntry{Details: "Computing coverage"},
+	})
+
+	status, err := app.JobStatus(jobID)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if status.Complete || status.Error == "" {
+		t.Errorf("expected job without a running computation to have failed, got: %#v", status)
+	}
+}
+
+type mockCompilingRunner struct {
+	mockFileRunner
+	compiled map[string]int
+	released map[string]int
+}
+
+func (m mockCompilingRunner) CompileTests(pkg string, opts *api.TestOptions) func() {
+	m.compiled[pkg]++
+	return func() {
+		m.released[pkg]++
+	}
+}
+
+func TestComputeFileContentsByTest_CompilesTestsOnce(t *testing.T) {
+	runner := mockCompilingRunner{compiled: map[string]int{}, released: map[string]int{}}
+	tests := []string{"TestFuncOne", "TestFuncTwo"}
+
+	_, _, _, err := computeFileContentsByTest(computationConfig{
+		ctx:               context.Background(),
+		testCoverageCache: memCache.New(),
+		progress:          newProgressTracker(func(jobProgress) {}),
+		runner:            runner,
+		JobConfig: JobConfig{
+			pkg:   "testdata",
+			tests: tests,
+			sort:  sortHardcodedOrder(tests),
+		},
+	})
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if runner.compiled["testdata"] != 1 || runner.released["testdata"] != 1 {
+		t.Errorf("expected tests to be compiled and released once, compiled %d times, released %d times", runner.compiled["testdata"], runner.released["testdata"])
+	}
+}
@@ -10,6 +10,7 @@ const (
 	READ requestType = iota
 	WRITE
 	DELETE
+	KEYS
 )
 
 func New() Cache {
@@ -48,6 +49,17 @@ func (c Cache) Read(key string) interface{} {
 	return got.Payload
 }
 
+// Keys returns the keys of every entry in the cache, in no particular order
+func (c Cache) Keys() []string {
+	out := make(chan request)
+	c <- request{
+		Type: KEYS,
+		Out:  out,
+	}
+	got := <-out
+	return got.Payload.([]string)
+}
+
 type request struct {
 	Type    requestType
 	Payload interface{}
@@ -72,6 +84,13 @@ func initialize(ch chan request, initial map[string]interface{}) {
 			store[req.Key] = req.Payload
 		case DELETE:
 			delete(store, req.Key)
+		case KEYS:
+			keys := make([]string, 0, len(store))
+			for k := range store {
+				keys = append(keys, k)
+			}
+			req.Payload = keys
+			req.Out <- req
 		}
 	}
 }
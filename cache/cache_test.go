@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -56,3 +58,15 @@ func TestCacheDelete(t *testing.T) {
 		t.Errorf("Expected nil payload when reading deleted key")
 	}
 }
+
+func TestCacheKeys(t *testing.T) {
+	ch := From(map[string]interface{}{"a": 1, "b": 2})
+	ch.Delete("b")
+	ch.Write("c", 3)
+
+	got := ch.Keys()
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("Got keys %v; expected [a c]", got)
+	}
+}
@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Disk is a cache that persists its entries as files in a directory, so they
+// survive restarts. Entries are kept in memory once they've been read or
+// written. Values are encoded with encoding/gob, so their concrete types must
+// be registered with gob.Register.
+type Disk struct {
+	dir string
+	mem Cache
+	// mu serializes access to the files, and keeps the in memory
+	// entries consistent with them
+	mu sync.Mutex
+}
+
+// NewDisk returns a cache storing its entries in dir, which is created if
+// it doesn't exist. Entries already in dir are available from the cache.
+func NewDisk(dir string) (*Disk, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Disk{
+		dir: dir,
+		mem: New(),
+	}, nil
+}
+
+func (d *Disk) Write(key string, entry interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.mem.Write(key, entry)
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&entry)
+	if err != nil {
+		log.Printf("failed to encode cache entry %s: %s", key, err)
+		return
+	}
+
+	// Write to a temporary file and rename it so that a crash part way
+	// through doesn't leave a corrupt entry behind
+	f, err := ioutil.TempFile(d.dir, ".tmp-")
+	if err != nil {
+		log.Printf("failed to write cache entry %s: %s", key, err)
+		return
+	}
+	_, err = f.Write(buf.Bytes())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.filename(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		log.Printf("failed to write cache entry %s: %s", key, err)
+	}
+}
+
+func (d *Disk) Delete(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.mem.Delete(key)
+
+	err := os.Remove(d.filename(key))
+	if err != nil && !os.IsNotExist(err) {
+		log.Printf("failed to delete cache entry %s: %s", key, err)
+	}
+}
+
+func (d *Disk) Read(key string) interface{} {
+	if entry := d.mem.Read(key); entry != nil {
+		return entry
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// The entry may have been written while waiting for the lock
+	if entry := d.mem.Read(key); entry != nil {
+		return entry
+	}
+
+	data, err := ioutil.ReadFile(d.filename(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("failed to read cache entry %s: %s", key, err)
+		}
+		return nil
+	}
+
+	var entry interface{}
+	err = gob.NewDecoder(bytes.NewReader(data)).Decode(&entry)
+	if err != nil {
+		log.Printf("failed to decode cache entry %s: %s", key, err)
+		return nil
+	}
+
+	d.mem.Write(key, entry)
+	return entry
+}
+
+// Keys returns the keys of every entry in the cache, in no particular order
+func (d *Disk) Keys() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	infos, err := ioutil.ReadDir(d.dir)
+	if err != nil {
+		log.Printf("failed to list cache entries: %s", err)
+		return nil
+	}
+
+	var keys []string
+	for _, info := range infos {
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".tmp-") {
+			continue
+		}
+		key, err := url.PathUnescape(info.Name())
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// filename returns the name of the file the entry for key is stored in
+func (d *Disk) filename(key string) string {
+	return filepath.Join(d.dir, url.PathEscape(key))
+}
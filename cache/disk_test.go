@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"encoding/gob"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type diskTestEntry struct {
+	Name  string
+	Files map[string][]byte
+}
+
+func init() {
+	gob.Register(diskTestEntry{})
+}
+
+func TestDiskPersistsEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	expectedValue := diskTestEntry{Name: "job", Files: map[string][]byte{"a.go": []byte("package a")}}
+	key := "pkg/with/slashes-TestOne"
+
+	d, err := NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Write(key, expectedValue)
+
+	// A new cache using the same directory should see the entry
+	reopened, err := NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := reopened.Read(key)
+	if !reflect.DeepEqual(got, expectedValue) {
+		t.Errorf("Read %#v from reopened cache; expected %#v", got, expectedValue)
+	}
+}
+
+func TestDiskDelete(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	d, err := NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Write("key", "value")
+	d.Delete("key")
+
+	if got := d.Read("key"); got != nil {
+		t.Errorf("Expected nil payload when reading deleted key, got %#v", got)
+	}
+
+	reopened, err := NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reopened.Read("key"); got != nil {
+		t.Errorf("Expected deleted key to be removed from disk, got %#v", got)
+	}
+}
+
+func TestDiskKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	d, err := NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Write("pkg/one", "value")
+	d.Write("two", "value")
+
+	reopened, err := NewDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := reopened.Keys()
+	sort.Strings(got)
+	if expected := []string{"pkg/one", "two"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got keys %v; expected %v", got, expected)
+	}
+}
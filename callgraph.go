@@ -0,0 +1,504 @@
+package commitlog
+
+import (
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/tools/cover"
+	"golang.org/x/tools/go/callgraph"
+	"golang.org/x/tools/go/callgraph/cha"
+	"golang.org/x/tools/go/ssa"
+)
+
+// declaredFunc is a function or method declared in the source of a package,
+// along with the lines it spans
+type declaredFunc struct {
+	name      string
+	file      string
+	startLine int
+	endLine   int
+	// callees are the other declared functions it calls
+	callees []*declaredFunc
+	// height is the length of the longest chain of calls from the function
+	// through other declared functions, so functions that don't call any
+	// have a height of 0
+	height int
+}
+
+// buildCallGraph parses and type checks the packages of the given files and
+// returns the functions and methods they declare, ordered by file and line,
+// each with the declared functions it calls. importPaths gives the import
+// path of the package in each directory, so that calls into the other
+// packages of the files are followed too. Packages without an import path
+// are only followed within. Files that can't be parsed are left out.
+//
+// The calls are found in a static call graph built with class hierarchy
+// analysis, so calls through interfaces and function values lead to every
+// declared function they could reach. Calls made in function literals are
+// calls of the function they're declared in, and calls through method
+// values and other wrappers are calls of the wrapped method. Packages that
+// don't type check, or import one of the packages that doesn't, still have
+// their functions returned, without callees.
+func buildCallGraph(filenames []string, importPaths map[string]string) []*declaredFunc {
+	var (
+		fset       = token.NewFileSet()
+		filesByDir = map[string][]*ast.File{}
+		dirs       []string
+		namesByDir = map[string][]string{}
+		dirsByPath = map[string]string{}
+	)
+
+	for _, fn := range filenames {
+		dir := filepath.Dir(fn)
+		if namesByDir[dir] == nil {
+			dirs = append(dirs, dir)
+			if path, ok := importPaths[dir]; ok {
+				dirsByPath[path] = dir
+			}
+		}
+		namesByDir[dir] = append(namesByDir[dir], fn)
+	}
+
+	for _, dir := range dirs {
+		for _, fn := range packageFiles(dir, namesByDir[dir]) {
+			file, err := parser.ParseFile(fset, fn, nil, 0)
+			if err != nil {
+				continue
+			}
+			filesByDir[dir] = append(filesByDir[dir], file)
+		}
+	}
+
+	checker := sourceChecker{
+		fset:        fset,
+		filesByDir:  filesByDir,
+		dirsByPath:  dirsByPath,
+		importPaths: importPaths,
+		packages:    map[string]*sourcePackage{},
+		fallback:    importer.Default(),
+	}
+	var (
+		funcs  []*declaredFunc
+		byDecl = map[*ast.FuncDecl]*declaredFunc{}
+	)
+	for _, dir := range dirs {
+		pkg := checker.check(dir)
+		for _, file := range pkg.files {
+			for _, decl := range file.Decls {
+				decl, ok := decl.(*ast.FuncDecl)
+				if !ok || decl.Body == nil || pkg.info.Defs[decl.Name] == nil {
+					continue
+				}
+				start, end := fset.Position(decl.Pos()), fset.Position(decl.End())
+				f := &declaredFunc{
+					name:      funcName(decl),
+					file:      start.Filename,
+					startLine: start.Line,
+					endLine:   end.Line,
+				}
+				byDecl[decl] = f
+				funcs = append(funcs, f)
+			}
+		}
+	}
+
+	addCallees(checker.program(dirs), byDecl)
+
+	sort.Slice(funcs, func(i, j int) bool {
+		if funcs[i].file != funcs[j].file {
+			return funcs[i].file < funcs[j].file
+		}
+		return funcs[i].startLine < funcs[j].startLine
+	})
+	setCallHeights(funcs)
+	return funcs
+}
+
+// packageFiles returns the go files of the package in dir, so that the
+// package can be type checked from the given files of it, or only the files
+// given if the package can't be found or doesn't include all of them
+func packageFiles(dir string, given []string) []string {
+	pkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return given
+	}
+
+	var files []string
+	included := map[string]bool{}
+	for _, name := range pkg.GoFiles {
+		fn := filepath.Join(dir, name)
+		files = append(files, fn)
+		included[fn] = true
+	}
+	for _, fn := range given {
+		if !included[fn] {
+			return given
+		}
+	}
+	return files
+}
+
+// sourcePackage is a package type checked from the files given to
+// buildCallGraph
+type sourcePackage struct {
+	files []*ast.File
+	types *types.Package
+	info  *types.Info
+	// ok is whether the package and the packages it imports from source
+	// type checked without errors
+	ok bool
+}
+
+// sourceChecker type checks the packages of the files given to
+// buildCallGraph, importing the other packages among them from their source
+// and every other package from its export data
+type sourceChecker struct {
+	fset        *token.FileSet
+	filesByDir  map[string][]*ast.File
+	dirsByPath  map[string]string
+	importPaths map[string]string
+	packages    map[string]*sourcePackage
+	fallback    types.Importer
+}
+
+// check type checks the package in dir, unless it already has been
+func (c *sourceChecker) check(dir string) *sourcePackage {
+	if pkg, ok := c.packages[dir]; ok {
+		return pkg
+	}
+
+	pkg := &sourcePackage{
+		files: c.filesByDir[dir],
+		info: &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Defs:       make(map[*ast.Ident]types.Object),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Implicits:  make(map[ast.Node]types.Object),
+			Instances:  make(map[*ast.Ident]types.Instance),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+			Scopes:     make(map[ast.Node]*types.Scope),
+		},
+		ok: true,
+	}
+	c.packages[dir] = pkg
+
+	path, ok := c.importPaths[dir]
+	if !ok {
+		path = dir
+	}
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			if dir, ok := c.dirsByPath[path]; ok {
+				imported := c.check(dir)
+				pkg.ok = pkg.ok && imported.ok
+				return imported.types, nil
+			}
+			return c.fallback.Import(path)
+		}),
+		// Functions are still listed when the package doesn't type check
+		Error: func(error) {
+			pkg.ok = false
+		},
+	}
+	pkg.types, _ = conf.Check(path, c.fset, pkg.files, pkg.info)
+	return pkg
+}
+
+// program builds the SSA form of the packages in dirs that type checked,
+// along with every package they import
+func (c *sourceChecker) program(dirs []string) *ssa.Program {
+	prog := ssa.NewProgram(c.fset, 0)
+	created := map[*types.Package]bool{}
+	var createImports func(p *types.Package)
+	createImports = func(p *types.Package) {
+		for _, imported := range p.Imports() {
+			if !created[imported] {
+				created[imported] = true
+				prog.CreatePackage(imported, nil, nil, true)
+				createImports(imported)
+			}
+		}
+	}
+
+	for _, dir := range dirs {
+		if pkg := c.packages[dir]; pkg.ok {
+			created[pkg.types] = true
+			prog.CreatePackage(pkg.types, pkg.files, pkg.info, true)
+		}
+	}
+	for _, dir := range dirs {
+		if pkg := c.packages[dir]; pkg.ok {
+			createImports(pkg.types)
+		}
+	}
+	prog.Build()
+	return prog
+}
+
+// importerFunc implements types.Importer with a function
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// addCallees sets the callees of the declared functions in byDecl from the
+// call graph of prog
+func addCallees(prog *ssa.Program, byDecl map[*ast.FuncDecl]*declaredFunc) {
+	// declared returns the declared function whose body fn is part of, or
+	// nil if fn isn't part of one
+	var declared func(fn *ssa.Function) *declaredFunc
+	declared = func(fn *ssa.Function) *declaredFunc {
+		if origin := fn.Origin(); origin != nil {
+			fn = origin
+		}
+		if parent := fn.Parent(); parent != nil {
+			return declared(parent)
+		}
+		decl, ok := fn.Syntax().(*ast.FuncDecl)
+		if !ok {
+			return nil
+		}
+		return byDecl[decl]
+	}
+
+	// Function literals have their own nodes, so a function's callees are
+	// collected from several
+	seen := map[*declaredFunc]map[*declaredFunc]bool{}
+	graph := cha.CallGraph(prog)
+	for fn, node := range graph.Nodes {
+		if fn == nil {
+			continue
+		}
+		caller := declared(fn)
+		if caller == nil {
+			continue
+		}
+		if seen[caller] == nil {
+			seen[caller] = map[*declaredFunc]bool{caller: true}
+		}
+
+		visited := map[*callgraph.Node]bool{}
+		var follow func(node *callgraph.Node)
+		follow = func(node *callgraph.Node) {
+			for _, edge := range node.Out {
+				callee := edge.Callee
+				if visited[callee] {
+					continue
+				}
+				visited[callee] = true
+				if f := declared(callee.Func); f != nil {
+					if !seen[caller][f] {
+						seen[caller][f] = true
+						caller.callees = append(caller.callees, f)
+					}
+				} else if callee.Func.Synthetic != "" {
+					// Wrappers for method values, interface methods and
+					// promoted methods call the method they wrap
+					follow(callee)
+				}
+			}
+		}
+		follow(node)
+	}
+
+	// Map iteration leaves the callees in any order
+	for _, f := range byDecl {
+		sort.Slice(f.callees, func(i, j int) bool {
+			if f.callees[i].file != f.callees[j].file {
+				return f.callees[i].file < f.callees[j].file
+			}
+			return f.callees[i].startLine < f.callees[j].startLine
+		})
+	}
+}
+
+// funcName returns the name of a declared function, prefixed with the type
+// of its receiver for methods, like T.Method
+func funcName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.Name
+	}
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	return types.ExprString(recv) + "." + decl.Name.Name
+}
+
+// setCallHeights sets the height of every function in funcs. Calls that
+// lead back to a function already on the chain, through recursion, don't
+// add to its height.
+func setCallHeights(funcs []*declaredFunc) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[*declaredFunc]int{}
+
+	var visit func(f *declaredFunc)
+	visit = func(f *declaredFunc) {
+		state[f] = visiting
+		f.height = 0
+		for _, callee := range f.callees {
+			switch state[callee] {
+			case visiting:
+				continue
+			case unvisited:
+				visit(callee)
+			}
+			if callee.height+1 > f.height {
+				f.height = callee.height + 1
+			}
+		}
+		state[f] = visited
+	}
+
+	for _, f := range funcs {
+		if state[f] == unvisited {
+			visit(f)
+		}
+	}
+}
+
+// sortTestsByCallGraph returns a sorting function that orders tests so that
+// functions are introduced before, or in the same step as, the functions
+// calling them. The call graph is built from the source of the files the
+// tests cover, which are looked up relative to pkg. At each step the test
+// whose newly covered functions sit lowest in the call graph comes next,
+// then the one introducing the fewest new functions, then the one adding
+// the fewest new lines.
+func sortTestsByCallGraph(pkg string) testSortingFunction {
+	return func(testProfiles testProfileData, durations testDurations) sortResult {
+		var tests []string
+		for test := range testProfiles {
+			tests = append(tests, test)
+		}
+		funcsByTest := coveredFunctions(pkg, testProfiles)
+
+		var (
+			sortedTests      []string
+			introduced       = map[*declaredFunc]struct{}{}
+			existingCoverage []*cover.Profile
+		)
+		for len(tests) > 0 {
+			var (
+				best                            = -1
+				bestHeight, bestFuncs, bestGain int
+				bestCoverage                    []*cover.Profile
+			)
+			for i, test := range tests {
+				// Tests that introduce no functions have a height of -1
+				height, newFuncs := -1, 0
+				for f := range funcsByTest[test] {
+					if _, ok := introduced[f]; ok {
+						continue
+					}
+					newFuncs++
+					if f.height > height {
+						height = f.height
+					}
+				}
+				coverage, gain := mergeProfiles(existingCoverage, testProfiles[test])
+
+				better := best == -1
+				switch {
+				case better:
+				case height != bestHeight:
+					better = height < bestHeight
+				case newFuncs != bestFuncs:
+					better = newFuncs < bestFuncs
+				case gain != bestGain:
+					better = gain < bestGain
+				default:
+					better = durations.before(test, tests[best])
+				}
+				if better {
+					best, bestHeight, bestFuncs, bestGain, bestCoverage = i, height, newFuncs, gain, coverage
+				}
+			}
+
+			test := tests[best]
+			sortedTests = append(sortedTests, test)
+			existingCoverage = bestCoverage
+			for f := range funcsByTest[test] {
+				introduced[f] = struct{}{}
+			}
+			tests = append(tests[:best], tests[best+1:]...)
+		}
+		return sortResult{Order: sortedTests}
+	}
+}
+
+// coveredFunctions returns the declared functions each test covers part of.
+// The functions are found in the files the tests cover, which are looked up
+// relative to pkg.
+func coveredFunctions(pkg string, testProfiles testProfileData) map[string]map[*declaredFunc]struct{} {
+	var (
+		paths       = map[string]string{}
+		filenames   []string
+		importPaths = map[string]string{}
+		funcsByTest = map[string]map[*declaredFunc]struct{}{}
+	)
+
+	for _, profiles := range testProfiles {
+		for _, profile := range profiles {
+			if _, ok := paths[profile.FileName]; ok {
+				continue
+			}
+			// Files that can't be found just don't have functions
+			path, _ := findFile(profile.FileName, pkg)
+			paths[profile.FileName] = path
+			if path == "" {
+				continue
+			}
+			filenames = append(filenames, path)
+			// Files of packages outside GOPATH and modules are named by
+			// their full path, other files by their package's import path
+			if !filepath.IsAbs(profile.FileName) {
+				importPaths[filepath.Dir(path)] = filepath.ToSlash(filepath.Dir(profile.FileName))
+			}
+		}
+	}
+	sort.Strings(filenames)
+
+	funcsByFile := map[string][]*declaredFunc{}
+	for _, f := range buildCallGraph(filenames, importPaths) {
+		funcsByFile[f.file] = append(funcsByFile[f.file], f)
+	}
+
+	for test, profiles := range testProfiles {
+		funcs := map[*declaredFunc]struct{}{}
+		for _, profile := range profiles {
+			for _, b := range profile.Blocks {
+				if b.Count == 0 {
+					continue
+				}
+				if f := enclosingFunc(funcsByFile[paths[profile.FileName]], b.StartLine); f != nil {
+					funcs[f] = struct{}{}
+				}
+			}
+		}
+		funcsByTest[test] = funcs
+	}
+	return funcsByTest
+}
+
+// enclosingFunc returns the function in funcs, which are ordered by line,
+// that spans line, or nil if none does
+func enclosingFunc(funcs []*declaredFunc, line int) *declaredFunc {
+	i := sort.Search(len(funcs), func(i int) bool {
+		return funcs[i].endLine >= line
+	})
+	if i < len(funcs) && funcs[i].startLine <= line {
+		return funcs[i]
+	}
+	return nil
+}
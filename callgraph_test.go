@@ -0,0 +1,96 @@
+package commitlog
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestBuildCallGraph(t *testing.T) {
+	file, err := filepath.Abs("testdata/callgraph/calls.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	heights := map[string]int{}
+	for _, f := range buildCallGraph([]string{file}, nil) {
+		heights[f.name] = f.height
+	}
+
+	// Countdown only calls itself
+	expected := map[string]int{
+		"Greeter.Greet": 2,
+		"greeting":      1,
+		"clean":         0,
+		"Countdown":     0,
+	}
+	if !reflect.DeepEqual(heights, expected) {
+		t.Errorf("expected heights %v, got %v", expected, heights)
+	}
+}
+
+func TestBuildCallGraph_Dynamic(t *testing.T) {
+	dir, err := filepath.Abs("testdata/callgraph/dynamic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shoutDir := filepath.Join(dir, "shout")
+	files := []string{filepath.Join(dir, "dynamic.go"), filepath.Join(shoutDir, "shout.go")}
+	importPaths := map[string]string{
+		dir:      "example.com/callgraph/dynamic",
+		shoutDir: "example.com/callgraph/dynamic/shout",
+	}
+
+	heights := map[string]int{}
+	for _, f := range buildCallGraph(files, importPaths) {
+		heights[f.name] = f.height
+	}
+
+	// Speak is called through an interface, Next through a method value
+	// and Skip from a function literal, and Upper is in another package
+	expected := map[string]int{
+		"exclaim":      0,
+		"Upper":        1,
+		"Loud.Speak":   2,
+		"Announce":     3,
+		"Counter.Next": 0,
+		"Skip":         1,
+		"Twice":        2,
+	}
+	if !reflect.DeepEqual(heights, expected) {
+		t.Errorf("expected heights %v, got %v", expected, heights)
+	}
+}
+
+func TestSortTestsByCallGraph(t *testing.T) {
+	file, err := filepath.Abs("testdata/callgraph/calls.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		greet     = cover.ProfileBlock{StartLine: 9, StartCol: 34, EndLine: 11, EndCol: 2, Count: 1}
+		greeting  = cover.ProfileBlock{StartLine: 13, StartCol: 35, EndLine: 15, EndCol: 2, Count: 1}
+		clean     = cover.ProfileBlock{StartLine: 17, StartCol: 32, EndLine: 19, EndCol: 2, Count: 1}
+		countdown = cover.ProfileBlock{StartLine: 21, StartCol: 27, EndLine: 26, EndCol: 2, Count: 1}
+	)
+	profile := func(blocks ...cover.ProfileBlock) []*cover.Profile {
+		return []*cover.Profile{{FileName: file, Mode: "set", Blocks: blocks}}
+	}
+
+	// TestGreet adds the fewest lines to the tests before it, but calls
+	// every other function it covers, so it comes last
+	profiles := testProfileData{
+		"TestGreet":     profile(greet, greeting, clean),
+		"TestGreeting":  profile(greeting, clean),
+		"TestClean":     profile(clean),
+		"TestCountdown": profile(countdown),
+	}
+
+	expected := []string{"TestClean", "TestCountdown", "TestGreeting", "TestGreet"}
+	actual := sortTestsByCallGraph("testdata/callgraph")(profiles, testDurations{}).Order
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
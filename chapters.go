@@ -0,0 +1,230 @@
+package commitlog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"commitlog/api"
+
+	"golang.org/x/tools/cover"
+)
+
+// chapterSimilarity is how similar the tests of two chapters have to be on
+// average for the chapters to be merged
+const chapterSimilarity = 0.3
+
+// chapterTitleFuncs is the number of functions named in a chapter's title
+const chapterTitleFuncs = 2
+
+// sortTestsByChapter returns a sorting function that orders tests chapter by
+// chapter, as grouped and ordered by testChapters, and reports the chapter
+// of each test titled by chapterTitles, looking up functions relative to pkg
+func sortTestsByChapter(pkg string) testSortingFunction {
+	return func(testProfiles testProfileData, durations testDurations) sortResult {
+		var (
+			chapters = testChapters(testProfiles, durations)
+			titles   = chapterTitles(pkg, testProfiles, chapters)
+			result   = sortResult{Chapters: map[string]string{}}
+		)
+		for i, chapter := range chapters {
+			result.Order = append(result.Order, chapter...)
+			for _, test := range chapter {
+				result.Chapters[test] = titles[i]
+			}
+		}
+		return result
+	}
+}
+
+// testChapters groups tests covering similar code into chapters, and returns
+// them in the order of the log, each with its tests in order. The similarity
+// of two tests is the number of blocks both cover over the number either
+// covers. Starting from a chapter per test, the two chapters whose tests are
+// most similar on average are merged, until no two chapters are at least
+// chapterSimilarity similar. The chapter adding the fewest lines to the ones
+// before it comes next, and the tests of each chapter are sorted as
+// sortTestsByNewLinesCovered does, after the chapter's examples.
+func testChapters(testProfiles testProfileData, durations testDurations) [][]string {
+	var (
+		tests        []string
+		blocksByTest []map[fileBlock]struct{}
+	)
+
+	for test := range testProfiles {
+		tests = append(tests, test)
+	}
+	sort.Slice(tests, func(i, j int) bool {
+		return durations.before(tests[i], tests[j])
+	})
+	for _, test := range tests {
+		blocksByTest = append(blocksByTest, coveredBlocks(testProfiles[test]...))
+	}
+
+	similarity := make([][]float64, len(tests))
+	for i := range tests {
+		similarity[i] = make([]float64, len(tests))
+		for j := range tests {
+			similarity[i][j] = blockSimilarity(blocksByTest[i], blocksByTest[j])
+		}
+	}
+
+	clusters := make([][]int, len(tests))
+	for i := range tests {
+		clusters[i] = []int{i}
+	}
+	for {
+		bestA, bestB, bestSimilarity := -1, -1, 0.0
+		for a := range clusters {
+			for b := a + 1; b < len(clusters); b++ {
+				total := 0.0
+				for _, i := range clusters[a] {
+					for _, j := range clusters[b] {
+						total += similarity[i][j]
+					}
+				}
+				average := total / float64(len(clusters[a])*len(clusters[b]))
+				if average >= chapterSimilarity && average > bestSimilarity {
+					bestA, bestB, bestSimilarity = a, b, average
+				}
+			}
+		}
+		if bestA == -1 {
+			break
+		}
+		clusters[bestA] = append(clusters[bestA], clusters[bestB]...)
+		clusters = append(clusters[:bestB], clusters[bestB+1:]...)
+	}
+
+	var (
+		chapters         [][]string
+		existingCoverage []*cover.Profile
+	)
+	for len(clusters) > 0 {
+		best, bestGain := -1, 0
+		for c, cluster := range clusters {
+			var profiles []*cover.Profile
+			for _, i := range cluster {
+				profiles = append(profiles, testProfiles[tests[i]]...)
+			}
+			if _, gain := mergeProfiles(existingCoverage, profiles); best == -1 || gain < bestGain {
+				best, bestGain = c, gain
+			}
+		}
+
+		chapterProfiles := testProfileData{}
+		for _, i := range clusters[best] {
+			chapterProfiles[tests[i]] = testProfiles[tests[i]]
+		}
+		var chapter []string
+		chapter, existingCoverage = sortByNewLinesCovered(chapterProfiles, durations, existingCoverage)
+		sort.SliceStable(chapter, func(i, j int) bool {
+			return testKind(chapter[i]) == api.TestKind_EXAMPLE && testKind(chapter[j]) != api.TestKind_EXAMPLE
+		})
+		chapters = append(chapters, chapter)
+		clusters = append(clusters[:best], clusters[best+1:]...)
+	}
+	return chapters
+}
+
+// fileBlock is a block of code in a file
+type fileBlock struct {
+	file string
+	cover.ProfileBlock
+}
+
+// coveredBlocks returns the blocks covered in a set of profiles. Their
+// counts are zeroed, so that the same block covered by different tests is
+// the same key.
+func coveredBlocks(pp ...*cover.Profile) map[fileBlock]struct{} {
+	blocks := map[fileBlock]struct{}{}
+	for _, p := range pp {
+		for _, b := range p.Blocks {
+			if b.Count == 0 {
+				continue
+			}
+			b.Count, b.NumStmt = 0, 0
+			blocks[fileBlock{file: p.FileName, ProfileBlock: b}] = struct{}{}
+		}
+	}
+	return blocks
+}
+
+// blockSimilarity returns the number of blocks in both a and b over the
+// number in either, or 0 if neither has any
+func blockSimilarity(a, b map[fileBlock]struct{}) float64 {
+	shared := 0
+	for block := range a {
+		if _, ok := b[block]; ok {
+			shared++
+		}
+	}
+	if total := len(a) + len(b) - shared; total > 0 {
+		return float64(shared) / float64(total)
+	}
+	return 0
+}
+
+// chapterTitles returns a title for each chapter, naming the functions
+// covered by the most tests of the chapter, preferring those covered by the
+// fewest tests outside it. Functions are looked up in the covered files
+// relative to pkg, and chapters covering none are numbered instead. Titles
+// shared by several chapters are numbered to tell them apart.
+func chapterTitles(pkg string, testProfiles testProfileData, chapters [][]string) []string {
+	var (
+		funcsByTest = coveredFunctions(pkg, testProfiles)
+		testsByFunc = map[*declaredFunc]int{}
+		titles      []string
+		seen        = map[string]int{}
+	)
+
+	for _, funcs := range funcsByTest {
+		for f := range funcs {
+			testsByFunc[f]++
+		}
+	}
+
+	for i, chapter := range chapters {
+		var (
+			funcs        []*declaredFunc
+			chapterTests = map[*declaredFunc]int{}
+		)
+		for _, test := range chapter {
+			for f := range funcsByTest[test] {
+				if chapterTests[f] == 0 {
+					funcs = append(funcs, f)
+				}
+				chapterTests[f]++
+			}
+		}
+		sort.Slice(funcs, func(i, j int) bool {
+			a, b := funcs[i], funcs[j]
+			if chapterTests[a] != chapterTests[b] {
+				return chapterTests[a] > chapterTests[b]
+			}
+			if testsByFunc[a] != testsByFunc[b] {
+				return testsByFunc[a] < testsByFunc[b]
+			}
+			return a.name < b.name
+		})
+
+		var names []string
+		for _, f := range funcs {
+			if len(names) == chapterTitleFuncs {
+				break
+			}
+			names = append(names, f.name)
+		}
+		title := strings.Join(names, ", ")
+		if title == "" {
+			title = fmt.Sprintf("Chapter %d", i+1)
+		}
+
+		seen[title]++
+		if seen[title] > 1 {
+			title = fmt.Sprintf("%s (%d)", title, seen[title])
+		}
+		titles = append(titles, title)
+	}
+	return titles
+}
@@ -0,0 +1,85 @@
+package commitlog
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestTestChapters(t *testing.T) {
+	lines := func(file string, ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: file, Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		"TestParse":      lines("parse.go", [2]int{1, 2}),
+		"TestParseMore":  lines("parse.go", [2]int{1, 2}, [2]int{3, 4}),
+		"TestFormat":     lines("format.go", [2]int{1, 2}),
+		"TestFormatMore": lines("format.go", [2]int{1, 2}, [2]int{3, 4}),
+		"ExampleFormat":  lines("format.go", [2]int{1, 2}, [2]int{3, 4}, [2]int{5, 6}),
+	}
+
+	// The parsing chapter adds fewer lines, so it comes first, and the
+	// example comes first in its chapter
+	expected := [][]string{
+		{"TestParse", "TestParseMore"},
+		{"ExampleFormat", "TestFormat", "TestFormatMore"},
+	}
+	if actual := testChapters(profiles, testDurations{}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+	// The covered files don't exist, so the chapters are numbered
+	result := sortTestsByChapter("testdata")(profiles, testDurations{})
+	if !reflect.DeepEqual(result.Order, append(expected[0], expected[1]...)) {
+		t.Errorf("Expected tests in chapter order, got %v", result.Order)
+	}
+	expectedChapters := map[string]string{
+		"TestParse":      "Chapter 1",
+		"TestParseMore":  "Chapter 1",
+		"ExampleFormat":  "Chapter 2",
+		"TestFormat":     "Chapter 2",
+		"TestFormatMore": "Chapter 2",
+	}
+	if !reflect.DeepEqual(result.Chapters, expectedChapters) {
+		t.Errorf("Expected %v, got %v", expectedChapters, result.Chapters)
+	}
+}
+
+func TestChapterTitles(t *testing.T) {
+	file, err := filepath.Abs("testdata/callgraph/calls.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		greet     = cover.ProfileBlock{StartLine: 9, StartCol: 34, EndLine: 11, EndCol: 2, Count: 1}
+		greeting  = cover.ProfileBlock{StartLine: 13, StartCol: 35, EndLine: 15, EndCol: 2, Count: 1}
+		clean     = cover.ProfileBlock{StartLine: 17, StartCol: 32, EndLine: 19, EndCol: 2, Count: 1}
+		countdown = cover.ProfileBlock{StartLine: 21, StartCol: 27, EndLine: 26, EndCol: 2, Count: 1}
+	)
+	profile := func(blocks ...cover.ProfileBlock) []*cover.Profile {
+		return []*cover.Profile{{FileName: file, Mode: "set", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		"TestGreet":      profile(greet, greeting, clean),
+		"TestGreeting":   profile(greeting, clean),
+		"TestCountdown":  profile(countdown),
+		"TestNothing":    profile(),
+		"TestCountAgain": profile(countdown),
+	}
+	chapters := [][]string{
+		{"TestGreeting", "TestGreet"},
+		{"TestCountdown"},
+		{"TestNothing"},
+		{"TestCountAgain"},
+	}
+
+	expected := []string{"clean, greeting", "Countdown", "Chapter 3", "Countdown (2)"}
+	if actual := chapterTitles("testdata/callgraph", profiles, chapters); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+}
@@ -2,49 +2,63 @@ package main
 
 import (
 	"commitlog/cache"
-	"io/ioutil"
+	"flag"
 	"log"
 	"net/http"
+	"path/filepath"
 
 	"commitlog"
+	"commitlog/api"
 	"commitlog/gocmd"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"golang.org/x/tools/cover"
 )
 
 type goPKGInfoProvider struct{}
 func (g goPKGInfoProvider) ListPackages() ([]string, error) {
 	return gocmd.List()
 }
-func (g goPKGInfoProvider) ListTests(pkg string) ([]string, error) {
-	return gocmd.TestList(pkg)
+func (g goPKGInfoProvider) ListTests(pkg string, opts *api.TestOptions) ([]string, error) {
+	return gocmd.TestList(pkg, opts)
 }
 
-type goTestRunner struct{}
-func (g goTestRunner) GetCoverage(pkg string, test string) ([]*cover.Profile, error) {
-	f, err := ioutil.TempFile("", "")
-	if err != nil {
-		return nil, err
+func main() {
+	dataDir := flag.String("data-dir", "", "directory to store job results in, so they survive restarts. Results are only kept in memory if empty")
+	flag.Parse()
+
+	var jobCache, jobSummaries interface {
+		Read(key string) interface{}
+		Write(key string, value interface{})
+		Delete(key string)
+		Keys() []string
+	} = cache.New(), cache.New()
+	if *dataDir != "" {
+		var err error
+		jobCache, err = cache.NewDisk(filepath.Join(*dataDir, "jobs"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		jobSummaries, err = cache.NewDisk(filepath.Join(*dataDir, "job-summaries"))
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
-	return gocmd.TestCover(pkg, test, f.Name())
-}
 
-func main() {
 	r := chi.NewRouter()
 	r.Use(middleware.Logger)
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins: []string{"https://*", "http://*"},
-		AllowedMethods: []string{"GET", "POST"},
+		AllowedMethods: []string{"GET", "POST", "DELETE"},
 		AllowedHeaders: []string{"Accept", "Content-Type"},
 	}))
 
 	commitLogApp := commitlog.NewCommitLogApp(
-		goTestRunner{},
-		cache.New(),
+		&gocmd.CoverageRunner{},
 		cache.New(),
+		jobCache,
+		jobSummaries,
 	)
 	commitLogHandler := commitlog.Handler{
 		Jobs: commitLogApp,
@@ -52,9 +66,16 @@ func main() {
 	}
 
 	r.Get("/job/{id:[0-9a-zA-Z-]+}", commitLogHandler.JobStatus)
+	r.Delete("/job/{id:[0-9a-zA-Z-]+}", commitLogHandler.DeleteJob)
+	r.Get("/job/{id:[0-9a-zA-Z-]+}/events", commitLogHandler.JobEvents)
 	r.Post("/job", commitLogHandler.StartJob)
+	r.Get("/jobs", commitLogHandler.ListJobs)
+	r.Delete("/jobs", commitLogHandler.DeleteJobs)
+	r.Post("/job/{id:[0-9a-zA-Z-]+}/export", commitLogHandler.ExportRepository)
+	r.Get("/job/{id:[0-9a-zA-Z-]+}/patches", commitLogHandler.Patches)
 	r.Post("/checkout", commitLogHandler.CheckoutFiles)
 	r.Get("/listTests", commitLogHandler.Tests)
+	r.Post("/listTests", commitLogHandler.Tests)
 	r.Get("/listPackages", commitLogHandler.Packages)
 	err := http.ListenAndServe(":3000", r)
 	if err != nil {
@@ -0,0 +1,255 @@
+// Command commitlog computes an idealized commit log for a package and writes
+// each step of it to disk, without starting the server.
+//
+// Usage:
+//
+//	commitlog -pkg <package> -sort <RAW|NET|IMPORTANCE|DURATION|FREQUENCY|MINIMAL|CALLGRAPH|CONTAINMENT|CHAPTERS|HARDCODED> [-tests TestA,TestB] [-coverpkg pkgA,pkgB] [test options] [-on-failure policy] [constraints] -out <dir>
+//
+// Tests default to every test in the package, and are required for the
+// HARDCODED sort, where they give the order of the log. Subtests are named
+// as go test reports them, like TestTable/case_one. -coverpkg builds the log
+// from the coverage of other packages, as for go test -coverpkg.
+//
+// The tests are built and run with the build tags given by -tags, the
+// environment variables given by each -env KEY=VALUE, and the -timeout,
+// -short and -count flags, which are passed on to go test. -testflags
+// passes any other space separated arguments on to go test. -covermode count
+// or atomic records how many times each block of code ran.
+//
+// -on-failure decides what happens to tests that don't pass: FAIL_FAST stops
+// at the first failing or timed out test, DROP_FAILING leaves failing,
+// timed out and skipped tests out of the log, and KEEP_PARTIAL builds the
+// log with whatever they covered before they stopped.
+//
+// Sorts other than HARDCODED order the tests around constraints: -first and
+// -last take comma separated tests that start and end the log, in order,
+// each -pin Test=step fixes the step of a test, and each -order
+// TestA,TestB makes TestA come before TestB.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"commitlog"
+	"commitlog/api"
+	"commitlog/cache"
+	"commitlog/gocmd"
+)
+
+// envFlag collects KEY=VALUE environment variables from repeated flags
+type envFlag map[string]string
+
+func (e envFlag) String() string {
+	var vars []string
+	for k, v := range e {
+		vars = append(vars, k+"="+v)
+	}
+	return strings.Join(vars, " ")
+}
+
+func (e envFlag) Set(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return fmt.Errorf("%q isn't of the form KEY=VALUE", s)
+	}
+	e[s[:i]] = s[i+1:]
+	return nil
+}
+
+// pinFlag collects Test=step pins from repeated flags
+type pinFlag []*api.SortConstraints_Pin
+
+func (p *pinFlag) String() string {
+	var pins []string
+	for _, pin := range *p {
+		pins = append(pins, fmt.Sprintf("%s=%d", pin.Test, pin.Step))
+	}
+	return strings.Join(pins, " ")
+}
+
+func (p *pinFlag) Set(s string) error {
+	i := strings.LastIndex(s, "=")
+	if i <= 0 {
+		return fmt.Errorf("%q isn't of the form Test=step", s)
+	}
+	step, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return fmt.Errorf("%q isn't of the form Test=step", s)
+	}
+	*p = append(*p, &api.SortConstraints_Pin{Test: s[:i], Step: int32(step)})
+	return nil
+}
+
+// orderFlag collects TestA,TestB orders from repeated flags
+type orderFlag []*api.SortConstraints_Order
+
+func (o *orderFlag) String() string {
+	var orders []string
+	for _, order := range *o {
+		orders = append(orders, order.Before+","+order.After)
+	}
+	return strings.Join(orders, " ")
+}
+
+func (o *orderFlag) Set(s string) error {
+	tests := strings.Split(s, ",")
+	if len(tests) != 2 {
+		return fmt.Errorf("%q isn't of the form TestA,TestB", s)
+	}
+	*o = append(*o, &api.SortConstraints_Order{Before: tests[0], After: tests[1]})
+	return nil
+}
+
+// lineWriter writes each status message it receives on its own line
+type lineWriter struct {
+	w *os.File
+}
+
+func (lw lineWriter) Write(p []byte) (int, error) {
+	_, err := fmt.Fprintf(lw.w, "%s\n", p)
+	return len(p), err
+}
+
+func main() {
+	var (
+		pkg      = flag.String("pkg", "", "package to generate a log for, either an import path or an absolute directory")
+		sort     = flag.String("sort", "IMPORTANCE", "test ordering: RAW, NET, IMPORTANCE, DURATION, FREQUENCY, MINIMAL, CALLGRAPH, CONTAINMENT, CHAPTERS or HARDCODED")
+		tests    = flag.String("tests", "", "comma separated tests to include, in order for HARDCODED sorts. Defaults to every test in the package")
+		out      = flag.String("out", "", "empty or non-existent directory to write the steps of the log to")
+		coverPkg = flag.String("coverpkg", "", "comma separated packages to collect coverage for, as for go test -coverpkg. Defaults to the package under test")
+		tags     = flag.String("tags", "", "comma separated build tags to build the tests with")
+		timeout  = flag.String("timeout", "", "timeout for each test run, as for go test -timeout")
+		short    = flag.Bool("short", false, "run the tests with -short")
+		count    = flag.Int("count", 0, "number of times to run each test, as for go test -count")
+		extra    = flag.String("testflags", "", "space separated extra arguments to pass to go test")
+		mode     = flag.String("covermode", "", "coverage mode, as for go test -covermode: set, count or atomic")
+		onFail   = flag.String("on-failure", "FAIL_FAST", "what to do with tests that don't pass: FAIL_FAST, DROP_FAILING or KEEP_PARTIAL")
+		first    = flag.String("first", "", "comma separated tests that start the log, in order")
+		last     = flag.String("last", "", "comma separated tests that end the log, in order")
+		env      = envFlag{}
+		pins     pinFlag
+		orders   orderFlag
+	)
+	flag.Var(env, "env", "KEY=VALUE environment variable to run the tests with, may be repeated")
+	flag.Var(&pins, "pin", "Test=step fixing the step of a test in the log, may be repeated")
+	flag.Var(&orders, "order", "TestA,TestB making TestA come before TestB in the log, may be repeated")
+	flag.Parse()
+	log.SetFlags(0)
+
+	if *pkg == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	sortType, ok := api.StartJobRequest_SortType_value[strings.ToUpper(*sort)]
+	if !ok {
+		log.Fatalf("unknown sort %q", *sort)
+	}
+	policy, ok := api.StartJobRequest_FailurePolicy_value[strings.ToUpper(*onFail)]
+	if !ok {
+		log.Fatalf("unknown failure policy %q", *onFail)
+	}
+
+	opts := &api.TestOptions{
+		Env:       env,
+		Timeout:   *timeout,
+		Short:     *short,
+		Count:     int32(*count),
+		Args:      strings.Fields(*extra),
+		CoverMode: *mode,
+	}
+	if *coverPkg != "" {
+		opts.CoverPkgs = strings.Split(*coverPkg, ",")
+	}
+	if *tags != "" {
+		opts.Tags = strings.Split(*tags, ",")
+	}
+	err := commitlog.ValidateTestOptions(opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var constraints *api.SortConstraints
+	if *first != "" || *last != "" || len(pins) > 0 || len(orders) > 0 {
+		constraints = &api.SortConstraints{Pins: pins, Orders: orders}
+		if *first != "" {
+			constraints.First = strings.Split(*first, ",")
+		}
+		if *last != "" {
+			constraints.Last = strings.Split(*last, ",")
+		}
+	}
+	err = commitlog.ValidateSortConstraints(constraints)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var testList []string
+	if *tests != "" {
+		testList = strings.Split(*tests, ",")
+	} else {
+		if api.StartJobRequest_SortType(sortType) == api.StartJobRequest_HARDCODED {
+			log.Fatal("-tests is required for HARDCODED sorts")
+		}
+
+		testList, err = gocmd.TestList(*pkg, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Stop running tests when interrupted
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	go func() {
+		<-interrupts
+		cancel()
+	}()
+
+	app := commitlog.NewCommitLogApp(&gocmd.CoverageRunner{}, cache.New(), cache.New(), cache.New())
+	result, err := app.RunJob(
+		ctx,
+		commitlog.NewJobConfig(*pkg, testList, api.StartJobRequest_SortType(sortType), opts, api.StartJobRequest_FailurePolicy(policy), constraints),
+		lineWriter{w: os.Stderr},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, o := range result.Outcomes {
+		if o.Outcome != api.TestOutcome_PASS {
+			log.Printf("%s: %s\n%s", o.Test, o.Outcome, o.Output)
+		}
+		if o.Redundant {
+			log.Printf("%s: left out, the log already covers everything it does", o.Test)
+		}
+		if len(o.Refines) > 0 {
+			log.Printf("%s: refines %s", o.Test, strings.Join(o.Refines, ", "))
+		}
+	}
+	outcomeByTest := map[string]int{}
+	for i, o := range result.Outcomes {
+		outcomeByTest[o.Test] = i
+	}
+	for i, test := range result.Tests {
+		o := result.Outcomes[outcomeByTest[test]]
+		if o.Chapter != "" && (i == 0 || result.Outcomes[outcomeByTest[result.Tests[i-1]]].Chapter != o.Chapter) {
+			log.Printf("step %d starts chapter %q", i+1, o.Chapter)
+		}
+		if m := o.Metrics; m != nil {
+			log.Printf("step %d, %s: +%d lines, %d of %d covered lines already in the log, importance %.2f", i+1, test, m.NewLines, m.Overlap, m.LinesCovered, m.Importance)
+		}
+	}
+
+	err = commitlog.WriteSteps(*out, result)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,334 @@
+package commitlog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"commitlog/api"
+)
+
+// ValidateSortConstraints checks that c, which may be nil, doesn't
+// contradict itself, whichever of its tests end up in the log
+func ValidateSortConstraints(c *api.SortConstraints) error {
+	placements := map[string]string{}
+	place := func(test, placement string) error {
+		if test == "" {
+			return errors.New("constraints can't name an empty test")
+		}
+		if other, ok := placements[test]; ok {
+			return fmt.Errorf("%s can't be both %s and %s", test, other, placement)
+		}
+		placements[test] = placement
+		return nil
+	}
+
+	for _, test := range c.GetFirst() {
+		if err := place(test, "first"); err != nil {
+			return err
+		}
+	}
+	for _, test := range c.GetLast() {
+		if err := place(test, "last"); err != nil {
+			return err
+		}
+	}
+	steps := map[int32]string{}
+	for _, pin := range c.GetPins() {
+		if pin.GetStep() < 1 {
+			return fmt.Errorf("%s is pinned to step %d, steps start from 1", pin.GetTest(), pin.GetStep())
+		}
+		if other, ok := steps[pin.GetStep()]; ok {
+			return fmt.Errorf("%s and %s are both pinned to step %d", other, pin.GetTest(), pin.GetStep())
+		}
+		steps[pin.GetStep()] = pin.GetTest()
+		if err := place(pin.GetTest(), fmt.Sprintf("step %d", pin.GetStep())); err != nil {
+			return err
+		}
+	}
+
+	pinned := map[string]int32{}
+	for _, pin := range c.GetPins() {
+		pinned[pin.GetTest()] = pin.GetStep()
+	}
+	after := map[string][]string{}
+	for _, o := range c.GetOrders() {
+		before, next := o.GetBefore(), o.GetAfter()
+		switch {
+		case before == "" || next == "":
+			return errors.New("constraints can't name an empty test")
+		case before == next:
+			return fmt.Errorf("%s can't come before itself", before)
+		case placements[next] == "first" && placements[before] != "first":
+			return fmt.Errorf("%s can't come before %s, which is first", before, next)
+		case placements[before] == "last" && placements[next] != "last":
+			return fmt.Errorf("%s can't come after %s, which is last", next, before)
+		case pinned[before] != 0 && pinned[next] != 0 && pinned[before] > pinned[next]:
+			return fmt.Errorf("%s can't come before %s, which is pinned to an earlier step", before, next)
+		}
+		after[before] = append(after[before], next)
+	}
+	// The first and last tests are in the order given
+	for _, tests := range [][]string{c.GetFirst(), c.GetLast()} {
+		for i := 1; i < len(tests); i++ {
+			after[tests[i-1]] = append(after[tests[i-1]], tests[i])
+		}
+	}
+	if test := orderCycle(after); test != "" {
+		return fmt.Errorf("%s has to come before itself through the order of the constraints", test)
+	}
+	return nil
+}
+
+// orderCycle returns a test that has to come before itself by following
+// after, which lists the tests each test has to come before, or an empty
+// string if there is none
+func orderCycle(after map[string][]string) string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		state = map[string]int{}
+		tests []string
+	)
+	for test := range after {
+		tests = append(tests, test)
+	}
+	sort.Strings(tests)
+
+	var visit func(test string) string
+	visit = func(test string) string {
+		state[test] = visiting
+		for _, next := range after[test] {
+			switch state[next] {
+			case visiting:
+				return next
+			case unvisited:
+				if cycle := visit(next); cycle != "" {
+					return cycle
+				}
+			}
+		}
+		state[test] = visited
+		return ""
+	}
+
+	for _, test := range tests {
+		if state[test] == unvisited {
+			if cycle := visit(test); cycle != "" {
+				return cycle
+			}
+		}
+	}
+	return ""
+}
+
+// sortWithConstraints returns a sorting function that orders tests as
+// sortFunc does, then reorders them to satisfy c, as applyConstraints does
+func sortWithConstraints(sortFunc testSortingFunction, c *api.SortConstraints) testSortingFunction {
+	return func(testProfiles testProfileData, durations testDurations) sortResult {
+		result := sortFunc(testProfiles, durations)
+		result.Order = applyConstraints(result.Order, c)
+		return result
+	}
+}
+
+// applyConstraints reorders tests so that they satisfy c. The first, last
+// and pinned tests are put in their steps, and the other tests fill the
+// steps left in the order they're given, except that each step is taken by
+// the first test that's free to go there without leaving too few steps for
+// the tests that have to come before a test in a later step. Constraints
+// that can't be satisfied are left for checkConstraints to report.
+func applyConstraints(tests []string, c *api.SortConstraints) []string {
+	var (
+		n      = len(tests)
+		inLog  = map[string]bool{}
+		slots  = make([]string, n)
+		slotOf = map[string]int{}
+	)
+	for _, test := range tests {
+		inLog[test] = true
+	}
+
+	fix := func(test string, slot int) {
+		if _, ok := slotOf[test]; ok || !inLog[test] || slot < 0 || slot >= n || slots[slot] != "" {
+			return
+		}
+		slots[slot] = test
+		slotOf[test] = slot
+	}
+	first, last := constrainedTests(c.GetFirst(), inLog), constrainedTests(c.GetLast(), inLog)
+	for i, test := range first {
+		fix(test, i)
+	}
+	for i, test := range last {
+		fix(test, n-len(last)+i)
+	}
+	for _, pin := range c.GetPins() {
+		fix(pin.GetTest(), int(pin.GetStep())-1)
+	}
+
+	var (
+		before = map[string][]string{}
+		after  = map[string][]string{}
+	)
+	for _, o := range c.GetOrders() {
+		if inLog[o.GetBefore()] && inLog[o.GetAfter()] {
+			before[o.GetAfter()] = append(before[o.GetAfter()], o.GetBefore())
+			after[o.GetBefore()] = append(after[o.GetBefore()], o.GetAfter())
+		}
+	}
+
+	// deadlines holds the last slot each test can take while still coming
+	// before the tests it has to
+	deadlines := map[string]int{}
+	var deadline func(test string) int
+	deadline = func(test string) int {
+		if slot, ok := slotOf[test]; ok {
+			return slot
+		}
+		if d, ok := deadlines[test]; ok {
+			return d
+		}
+		// Guards against cycles, which checkConstraints reports
+		deadlines[test] = n - 1
+		d := n - 1
+		for _, next := range after[test] {
+			if nextDeadline := deadline(next) - 1; nextDeadline < d {
+				d = nextDeadline
+			}
+		}
+		deadlines[test] = d
+		return d
+	}
+
+	// freeSlots[i] is the number of slots up to and including i that
+	// aren't fixed
+	freeSlots := make([]int, n)
+	var remaining []string
+	for i := range slots {
+		if i > 0 {
+			freeSlots[i] = freeSlots[i-1]
+		}
+		if slots[i] == "" {
+			freeSlots[i]++
+		}
+	}
+	for _, test := range tests {
+		if _, ok := slotOf[test]; !ok {
+			remaining = append(remaining, test)
+			deadline(test)
+		}
+	}
+
+	placed := map[string]bool{}
+	ready := func(test string) bool {
+		for _, b := range before[test] {
+			if !placed[b] {
+				return false
+			}
+		}
+		return true
+	}
+	// feasible reports whether the tests other than remaining[skip] can
+	// still meet their deadlines once slot is taken
+	feasible := func(slot, skip int) bool {
+		var ds []int
+		for i, test := range remaining {
+			if i != skip && deadlines[test] < n-1 {
+				ds = append(ds, deadlines[test])
+			}
+		}
+		sort.Ints(ds)
+		for i, d := range ds {
+			if d <= slot || freeSlots[d]-freeSlots[slot] < i+1 {
+				return false
+			}
+		}
+		return true
+	}
+
+	for slot := range slots {
+		if slots[slot] != "" {
+			placed[slots[slot]] = true
+			continue
+		}
+
+		pick := -1
+		for i, test := range remaining {
+			if ready(test) && feasible(slot, i) {
+				pick = i
+				break
+			}
+		}
+		// No choice satisfies every constraint, so the most urgent test
+		// goes next
+		if pick == -1 {
+			pick = 0
+			for i, test := range remaining {
+				if ready(test) && (!ready(remaining[pick]) || deadlines[test] < deadlines[remaining[pick]]) {
+					pick = i
+				}
+			}
+		}
+
+		slots[slot] = remaining[pick]
+		placed[remaining[pick]] = true
+		remaining = append(remaining[:pick], remaining[pick+1:]...)
+	}
+	return slots
+}
+
+// constrainedTests returns the tests that are in the log, keeping their order
+func constrainedTests(tests []string, inLog map[string]bool) []string {
+	var out []string
+	for _, test := range tests {
+		if inLog[test] {
+			out = append(out, test)
+		}
+	}
+	return out
+}
+
+// checkConstraints returns an error describing a constraint of c that the
+// order of tests doesn't satisfy, if there is one
+func checkConstraints(c *api.SortConstraints, tests []string) error {
+	var (
+		n     = len(tests)
+		inLog = map[string]bool{}
+		steps = map[string]int{}
+	)
+	for i, test := range tests {
+		inLog[test] = true
+		steps[test] = i + 1
+	}
+
+	first, last := constrainedTests(c.GetFirst(), inLog), constrainedTests(c.GetLast(), inLog)
+	for i, test := range first {
+		if steps[test] != i+1 {
+			return fmt.Errorf("can't order the log to satisfy its constraints: %s isn't step %d", test, i+1)
+		}
+	}
+	for i, test := range last {
+		if step := n - len(last) + i + 1; steps[test] != step {
+			return fmt.Errorf("can't order the log to satisfy its constraints: %s isn't step %d", test, step)
+		}
+	}
+	for _, pin := range c.GetPins() {
+		if !inLog[pin.GetTest()] || steps[pin.GetTest()] == int(pin.GetStep()) {
+			continue
+		}
+		if int(pin.GetStep()) > n {
+			return fmt.Errorf("can't order the log to satisfy its constraints: %s is pinned to step %d, but the log has %d steps", pin.GetTest(), pin.GetStep(), n)
+		}
+		return fmt.Errorf("can't order the log to satisfy its constraints: %s isn't step %d", pin.GetTest(), pin.GetStep())
+	}
+	for _, o := range c.GetOrders() {
+		if inLog[o.GetBefore()] && inLog[o.GetAfter()] && steps[o.GetBefore()] > steps[o.GetAfter()] {
+			return fmt.Errorf("can't order the log to satisfy its constraints: %s doesn't come before %s", o.GetBefore(), o.GetAfter())
+		}
+	}
+	return nil
+}
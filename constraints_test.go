@@ -0,0 +1,144 @@
+package commitlog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"commitlog/api"
+	memCache "commitlog/cache"
+)
+
+func TestValidateSortConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints *api.SortConstraints
+		valid       bool
+	}{
+		{"none", nil, true},
+		{"every kind", &api.SortConstraints{
+			First:  []string{"TestA", "TestB"},
+			Last:   []string{"TestZ"},
+			Pins:   []*api.SortConstraints_Pin{{Test: "TestC", Step: 4}, {Test: "TestD", Step: 6}},
+			Orders: []*api.SortConstraints_Order{{Before: "TestA", After: "TestE"}, {Before: "TestC", After: "TestD"}},
+		}, true},
+		{"first and last", &api.SortConstraints{First: []string{"TestA"}, Last: []string{"TestA"}}, false},
+		{"pinned twice", &api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "TestA", Step: 1}, {Test: "TestA", Step: 2}}}, false},
+		{"same step", &api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "TestA", Step: 2}, {Test: "TestB", Step: 2}}}, false},
+		{"step zero", &api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "TestA", Step: 0}}}, false},
+		{"empty test", &api.SortConstraints{Orders: []*api.SortConstraints_Order{{Before: "TestA"}}}, false},
+		{"before itself", &api.SortConstraints{Orders: []*api.SortConstraints_Order{{Before: "TestA", After: "TestA"}}}, false},
+		{"before first", &api.SortConstraints{
+			First:  []string{"TestA"},
+			Orders: []*api.SortConstraints_Order{{Before: "TestB", After: "TestA"}},
+		}, false},
+		{"after last", &api.SortConstraints{
+			Last:   []string{"TestA"},
+			Orders: []*api.SortConstraints_Order{{Before: "TestA", After: "TestB"}},
+		}, false},
+		{"against pins", &api.SortConstraints{
+			Pins:   []*api.SortConstraints_Pin{{Test: "TestA", Step: 1}, {Test: "TestB", Step: 2}},
+			Orders: []*api.SortConstraints_Order{{Before: "TestB", After: "TestA"}},
+		}, false},
+		{"against first order", &api.SortConstraints{
+			First:  []string{"TestA", "TestB"},
+			Orders: []*api.SortConstraints_Order{{Before: "TestB", After: "TestA"}},
+		}, false},
+		{"cycle", &api.SortConstraints{Orders: []*api.SortConstraints_Order{
+			{Before: "TestA", After: "TestB"},
+			{Before: "TestB", After: "TestC"},
+			{Before: "TestC", After: "TestA"},
+		}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSortConstraints(tt.constraints)
+			if (err == nil) != tt.valid {
+				t.Errorf("expected valid %t, got error %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+func TestApplyConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints *api.SortConstraints
+		expected    []string
+	}{
+		{"none", nil, []string{"A", "B", "C", "D", "E"}},
+		{"first", &api.SortConstraints{First: []string{"E", "D"}}, []string{"E", "D", "A", "B", "C"}},
+		{"last", &api.SortConstraints{Last: []string{"A"}}, []string{"B", "C", "D", "E", "A"}},
+		{"pinned", &api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "E", Step: 2}}}, []string{"A", "E", "B", "C", "D"}},
+		{"order", &api.SortConstraints{Orders: []*api.SortConstraints_Order{{Before: "E", After: "B"}}}, []string{"A", "C", "D", "E", "B"}},
+		// D has to come before the test in step 2, so it takes step 1
+		{"order before pinned", &api.SortConstraints{
+			Pins:   []*api.SortConstraints_Pin{{Test: "B", Step: 2}},
+			Orders: []*api.SortConstraints_Order{{Before: "D", After: "B"}},
+		}, []string{"D", "B", "A", "C", "E"}},
+		{"not in log", &api.SortConstraints{
+			First:  []string{"X"},
+			Pins:   []*api.SortConstraints_Pin{{Test: "Y", Step: 1}},
+			Orders: []*api.SortConstraints_Order{{Before: "E", After: "Z"}},
+		}, []string{"A", "B", "C", "D", "E"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := applyConstraints([]string{"A", "B", "C", "D", "E"}, tt.constraints)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+			if err := checkConstraints(tt.constraints, actual); err != nil {
+				t.Errorf("expected constraints to be satisfied, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints *api.SortConstraints
+	}{
+		{"first", &api.SortConstraints{First: []string{"B"}}},
+		{"last", &api.SortConstraints{Last: []string{"B"}}},
+		{"pinned", &api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "A", Step: 2}}}},
+		{"pinned past the end", &api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "A", Step: 4}}}},
+		{"order", &api.SortConstraints{Orders: []*api.SortConstraints_Order{{Before: "C", After: "A"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkConstraints(tt.constraints, []string{"A", "B", "C"}); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestComputeFileContentsByTest_Constraints(t *testing.T) {
+	tests := []string{"TestFuncOne", "TestFuncTwo", "TestFuncThree"}
+	run := func(constraints *api.SortConstraints) ([]string, error) {
+		sorted, _, _, err := computeFileContentsByTest(computationConfig{
+			ctx:               context.Background(),
+			testCoverageCache: memCache.New(),
+			progress:          newProgressTracker(func(jobProgress) {}),
+			runner:            mockFileRunner{},
+			JobConfig:         NewJobConfig("testdata", tests, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, constraints),
+		})
+		return sorted, err
+	}
+
+	sorted, err := run(&api.SortConstraints{First: []string{"TestFuncThree"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"TestFuncThree", "TestFuncOne", "TestFuncTwo"}; !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected %v, got %v", expected, sorted)
+	}
+
+	// The log only has three steps
+	_, err = run(&api.SortConstraints{Pins: []*api.SortConstraints_Pin{{Test: "TestFuncOne", Step: 5}}})
+	if err == nil {
+		t.Errorf("expected an error for unsatisfiable constraints")
+	}
+}
@@ -9,15 +9,21 @@ import (
 	"golang.org/x/tools/cover"
 )
 
-// coveredLines returns a set of line numbers of the
-// covered lines from a list of profiles
-func coveredLines(pp ...*cover.Profile) map[int]struct{} {
-	lines := map[int]struct{}{}
+// coveredLines returns the sets of line numbers of the
+// covered lines from a list of profiles, by file name
+func coveredLines(pp ...*cover.Profile) map[string]map[int]struct{} {
+	lines := map[string]map[int]struct{}{}
 
 	for _, p := range pp {
+		if lines[p.FileName] == nil {
+			lines[p.FileName] = map[int]struct{}{}
+		}
 		for _, b := range p.Blocks {
+			if b.Count == 0 {
+				continue
+			}
 			for line := b.StartLine; line <= b.EndLine; line++ {
-				lines[line] = struct{}{}
+				lines[p.FileName][line] = struct{}{}
 			}
 		}
 	}
@@ -28,7 +34,25 @@ func coveredLines(pp ...*cover.Profile) map[int]struct{} {
 // numLinesCovered returns the total number of covered lines
 // from a set of profiles
 func numLinesCovered(pp ...*cover.Profile) int {
-	return len(coveredLines(pp...))
+	total := 0
+	for _, lines := range coveredLines(pp...) {
+		total += len(lines)
+	}
+	return total
+}
+
+// coveredProfiles returns the profiles that cover at least one block
+func coveredProfiles(profiles []*cover.Profile) []*cover.Profile {
+	var out []*cover.Profile
+	for _, p := range profiles {
+		for _, b := range p.Blocks {
+			if b.Count > 0 {
+				out = append(out, p)
+				break
+			}
+		}
+	}
+	return out
 }
 
 // inUncoveredBlock returns whether or not the given position
@@ -67,12 +91,15 @@ func inUncoveredBlock(profile *cover.Profile, pos token.Position) bool {
 
 // mergeProfiles merges a set of new coverage profiles into a set of existing
 // coverage profiles. A profile block that is covered in any of the input profiles
-// will be covered in the output profiles. The function returns the new profiles, and
+// will be covered in the output profiles. Blocks from count and atomic mode profiles
+// keep the total of their hit counts, as go tool cover does when merging profiles,
+// while set mode blocks are only ever 0 or 1. The function returns the new profiles, and
 // a number representing the net lines covered added by the new profiles.
-// This function makes some assumptions about the profiles. First that the codeblocks between
-// the different profiles have the same positions in the code. Second that multiple new profiles
-// will not be provided for the same file. map[string]*cover.Profile might be a more representative
-// type, but less convenient. The Profiles/Blocks in the output are not necessarily ordered.
+// This function assumes that the codeblocks between the different profiles have the same
+// positions in the code. Either set of profiles may contain several profiles for the same
+// file, as they do when coverage spans several packages, and they are merged into one.
+// map[string]*cover.Profile might be a more representative type, but less convenient.
+// The Profiles/Blocks in the output are not necessarily ordered.
 func mergeProfiles(existingProfiles, newProfiles []*cover.Profile) ([]*cover.Profile, int) {
 	type blockPos struct {
 		SCol, ECol, SLine, ELine int
@@ -83,16 +110,33 @@ func mergeProfiles(existingProfiles, newProfiles []*cover.Profile) ([]*cover.Pro
 		existingProfilesByFile = map[string][]*cover.Profile{}
 		newProfilesByFile      = map[string][]*cover.Profile{}
 		filenames              = map[string]struct{}{}
+		mode                   string
 	)
 
 	for _, profile := range existingProfiles {
 		existingProfilesByFile[profile.FileName] = append(existingProfilesByFile[profile.FileName], profile)
 		filenames[profile.FileName] = struct{}{}
+		if mode == "" {
+			mode = profile.Mode
+		}
 	}
 
 	for _, profile := range newProfiles {
 		newProfilesByFile[profile.FileName] = append(newProfilesByFile[profile.FileName], profile)
 		filenames[profile.FileName] = struct{}{}
+		if mode == "" {
+			mode = profile.Mode
+		}
+	}
+
+	addCount := func(existing *cover.ProfileBlock, count int) {
+		if mode == "" || mode == "set" {
+			if count > 0 {
+				existing.Count = 1
+			}
+			return
+		}
+		existing.Count += count
 	}
 
 	for file, _ := range filenames {
@@ -102,6 +146,10 @@ func mergeProfiles(existingProfiles, newProfiles []*cover.Profile) ([]*cover.Pro
 			for _, block := range profile.Blocks {
 				block := block
 				pos := blockPos{SCol: block.StartCol, ECol: block.EndCol, SLine: block.StartLine, ELine: block.EndLine}
+				if existingBlock, ok := blockByPos[pos]; ok {
+					addCount(existingBlock, block.Count)
+					continue
+				}
 				blockByPos[pos] = &block
 			}
 		}
@@ -112,15 +160,13 @@ func mergeProfiles(existingProfiles, newProfiles []*cover.Profile) ([]*cover.Pro
 				block := block
 				pos := blockPos{SCol: block.StartCol, ECol: block.EndCol, SLine: block.StartLine, ELine: block.EndLine}
 				if existingBlock, ok := blockByPos[pos]; ok {
-					if block.Count == 1 {
-						if existingBlock.Count == 0 {
-							coverageGain += 1 + block.EndLine - block.StartLine
-							existingBlock.Count = 1
-						}
+					if block.Count > 0 && existingBlock.Count == 0 {
+						coverageGain += 1 + block.EndLine - block.StartLine
 					}
+					addCount(existingBlock, block.Count)
 				} else {
 					blockByPos[pos] = &block
-					if block.Count == 1 {
+					if block.Count > 0 {
 						coverageGain += 1 + block.EndLine - block.StartLine
 					}
 				}
@@ -134,6 +180,7 @@ func mergeProfiles(existingProfiles, newProfiles []*cover.Profile) ([]*cover.Pro
 
 		outProfiles = append(outProfiles, &cover.Profile{
 			FileName: file,
+			Mode:     mode,
 			Blocks:   blocks,
 		})
 	}
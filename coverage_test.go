@@ -120,6 +120,137 @@ func TestMergeProfiles(t *testing.T) {
 	}
 }
 
+func TestMergeProfiles_DuplicateFiles(t *testing.T) {
+	existingProfiles := []*cover.Profile{
+		{
+			FileName: "pkg/a.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 1},
+			},
+		},
+		{
+			FileName: "pkg/a.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 0},
+				{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 0},
+			},
+		},
+	}
+	newProfiles := []*cover.Profile{
+		{
+			FileName: "pkg/a.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 1},
+			},
+		},
+		{
+			FileName: "pkg/a.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 1},
+			},
+		},
+		{
+			FileName: "other/b.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 1},
+			},
+		},
+	}
+
+	merged, gain := mergeProfiles(existingProfiles, newProfiles)
+	if gain != 4 {
+		t.Errorf("Expected 4 new covered lines, got %d", gain)
+	}
+
+	expectedBlocks := map[string][]cover.ProfileBlock{
+		"pkg/a.go": {
+			{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 1},
+			{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 1},
+		},
+		"other/b.go": {
+			{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 1},
+		},
+	}
+	if len(merged) != len(expectedBlocks) {
+		t.Fatalf("Expected one profile per file, got %d profiles", len(merged))
+	}
+	for _, p := range merged {
+		if !blocksEqual(p.Blocks, expectedBlocks[p.FileName]) {
+			t.Errorf("unexpected blocks for file %s, found: %v, expected: %v", p.FileName, p.Blocks, expectedBlocks[p.FileName])
+		}
+	}
+}
+
+func TestNumLinesCovered_CountsEachFile(t *testing.T) {
+	profiles := []*cover.Profile{
+		{
+			FileName: "pkg/a.go",
+			Blocks:   []cover.ProfileBlock{{StartLine: 1, EndLine: 3, Count: 1}},
+		},
+		{
+			FileName: "other/a.go",
+			Blocks:   []cover.ProfileBlock{{StartLine: 1, EndLine: 3, Count: 1}},
+		},
+	}
+
+	if n := numLinesCovered(profiles...); n != 6 {
+		t.Errorf("Expected 6 lines covered, got %d", n)
+	}
+}
+
+func TestMergeProfiles_CountMode(t *testing.T) {
+	existingProfiles := []*cover.Profile{
+		{
+			FileName: "a.go",
+			Mode:     "count",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 3},
+				{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 0},
+			},
+		},
+	}
+	newProfiles := []*cover.Profile{
+		{
+			FileName: "a.go",
+			Mode:     "count",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 2},
+				{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 5},
+			},
+		},
+	}
+
+	merged, gain := mergeProfiles(existingProfiles, newProfiles)
+	if gain != 2 {
+		t.Errorf("Expected 2 new covered lines, got %d", gain)
+	}
+
+	expectedBlocks := []cover.ProfileBlock{
+		{StartLine: 1, StartCol: 0, EndLine: 2, EndCol: 0, Count: 5},
+		{StartLine: 3, StartCol: 0, EndLine: 4, EndCol: 0, Count: 5},
+	}
+	if len(merged) != 1 || merged[0].Mode != "count" || !blocksEqual(merged[0].Blocks, expectedBlocks) {
+		t.Errorf("unexpected merged profiles, found: %+v, expected blocks: %v", merged[0], expectedBlocks)
+	}
+}
+
+func TestNumLinesCovered_IgnoresUncoveredBlocks(t *testing.T) {
+	profiles := []*cover.Profile{
+		{
+			FileName: "a.go",
+			Mode:     "count",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, EndLine: 3, Count: 7},
+				{StartLine: 5, EndLine: 9, Count: 0},
+			},
+		},
+	}
+
+	if n := numLinesCovered(profiles...); n != 3 {
+		t.Errorf("Expected 3 lines covered, got %d", n)
+	}
+}
+
 func blocksEqual(b1, b2 []cover.ProfileBlock) bool {
 	if len(b1) != len(b2) { return false }
 
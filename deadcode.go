@@ -8,12 +8,14 @@ import (
 	"go/importer"
 	"go/token"
 	"go/types"
+	"path/filepath"
 )
 
 // findPositionsToDelete is a helper function that returns a set of unused identifiers.
 // It takes a map of asts by filename, a set of positions that contain active nodes
-// and a map with type usage information
-func findPositionsToDelete(astByName map[string]*ast.File, activePos map[token.Pos]struct{}, uses map[*ast.Ident]types.Object) map[token.Pos]struct{} {
+// and a map with type usage information. Exported identifiers are never deleted
+// when keepExported is set, for when other packages may use them.
+func findPositionsToDelete(astByName map[string]*ast.File, activePos map[token.Pos]struct{}, uses map[*ast.Ident]types.Object, keepExported bool) map[token.Pos]struct{} {
 	var (
 		referencedTypePositions = map[token.Pos]struct{}{}
 		deletionCandidates = map[token.Pos]struct{}{}
@@ -59,6 +61,9 @@ func findPositionsToDelete(astByName map[string]*ast.File, activePos map[token.P
 				if n.Name == "main" {
 					return true
 				}
+				if keepExported && n.IsExported() {
+					return true
+				}
 
 				if usedObj, ok := uses[n]; ok {
 					if _, ok := activePos[n.Pos()]; ok {
@@ -85,15 +90,19 @@ func findPositionsToDelete(astByName map[string]*ast.File, activePos map[token.P
 func removeDeadCode(trees map[string]*dst.File, fset *token.FileSet, decorators map[string]*decorator.Decorator) (map[string]*dst.File, bool, error) {
 	var (
 		codeDeleted = false
-		astByName   = map[string]*ast.File{}
-		astFiles    []*ast.File
+		// Files are grouped by directory, so that each package is type
+		// checked on its own when the trees span several packages
+		astByDir  = map[string]map[string]*ast.File{}
 		livingPOS = map[token.Pos]struct{}{}
 	)
 
 	for fn, d := range decorators {
 		astFile := d.Ast.Nodes[trees[fn]].(*ast.File)
-		astByName[fn] = astFile
-		astFiles = append(astFiles, astFile)
+		dir := filepath.Dir(fn)
+		if astByDir[dir] == nil {
+			astByDir[dir] = map[string]*ast.File{}
+		}
+		astByDir[dir][fn] = astFile
 	}
 
 	// The DSTs may have been manipulated but the ASTs stored in the decorator map
@@ -113,20 +122,33 @@ func removeDeadCode(trees map[string]*dst.File, fset *token.FileSet, decorators
 		})
 	}
 
-	conf := types.Config{
-		Importer:         importer.Default(),
-		IgnoreFuncBodies: false,
-		// Swallow errors, it's likely the input Files are invalid, for example
-		// because of unused imports remaining when uncovered code using them has been removed
-		Error:            func(error) {},
-	}
-	typesInfo := types.Info{
-		Defs: make(map[*ast.Ident]types.Object),
-		Uses: make(map[*ast.Ident]types.Object),
-	}
-	conf.Check("", fset, astFiles, &typesInfo)
+	deletionCandidates := map[token.Pos]struct{}{}
+	for _, astByName := range astByDir {
+		var astFiles []*ast.File
+		for _, astFile := range astByName {
+			astFiles = append(astFiles, astFile)
+		}
 
-	deletionCandidates := findPositionsToDelete(astByName, livingPOS, typesInfo.Uses)
+		conf := types.Config{
+			Importer:         importer.Default(),
+			IgnoreFuncBodies: false,
+			// Swallow errors, it's likely the input Files are invalid, for example
+			// because of unused imports remaining when uncovered code using them has been removed
+			Error:            func(error) {},
+		}
+		typesInfo := types.Info{
+			Defs: make(map[*ast.Ident]types.Object),
+			Uses: make(map[*ast.Ident]types.Object),
+		}
+		conf.Check("", fset, astFiles, &typesInfo)
+
+		// Uses from other packages aren't visible when type checking a
+		// package on its own, so exported identifiers have to be kept
+		candidates := findPositionsToDelete(astByName, livingPOS, typesInfo.Uses, len(astByDir) > 1)
+		for pos := range candidates {
+			deletionCandidates[pos] = struct{}{}
+		}
+	}
 
 	outFiles := map[string]*dst.File{}
 	for name, file := range trees {
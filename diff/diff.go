@@ -0,0 +1,324 @@
+// Package diff computes line based unified diffs between two versions of a file
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type OpKind int
+
+const (
+	Equal OpKind = iota
+	Insert
+	Delete
+)
+
+// Line is a single line of a diff. Text includes the trailing newline,
+// if the line has one.
+type Line struct {
+	Kind OpKind
+	Text string
+}
+
+// Hunk is a contiguous section of a unified diff. Start lines are 1 based,
+// and refer to the line before the hunk if the hunk is empty on that side,
+// following the conventions of diff -u.
+type Hunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []Line
+}
+
+// Header returns the hunk header, ex: @@ -1,4 +1,5 @@
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%s +%s @@", hunkRange(h.OldStart, h.OldLines), hunkRange(h.NewStart, h.NewLines))
+}
+
+func hunkRange(start, lines int) string {
+	if lines == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, lines)
+}
+
+// Added returns the number of lines inserted by the hunk
+func (h Hunk) Added() int {
+	return h.count(Insert)
+}
+
+// Removed returns the number of lines deleted by the hunk
+func (h Hunk) Removed() int {
+	return h.count(Delete)
+}
+
+func (h Hunk) count(kind OpKind) int {
+	n := 0
+	for _, l := range h.Lines {
+		if l.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// splitLines splits text into lines, keeping the line endings
+func splitLines(text []byte) []string {
+	var lines []string
+	for len(text) > 0 {
+		i := bytes.IndexByte(text, '\n')
+		if i < 0 {
+			lines = append(lines, string(text))
+			break
+		}
+		lines = append(lines, string(text[:i+1]))
+		text = text[i+1:]
+	}
+	return lines
+}
+
+// edits computes the shortest edit script turning a into b using the linear
+// space variant of Myers' algorithm, which finds the middle of the script
+// and recurses on either side of it. Within each run of changes, deleted
+// lines come before inserted ones.
+func edits(a, b []string) []Line {
+	if len(a)+len(b) == 0 {
+		return nil
+	}
+
+	size := 2*((len(a)+len(b)+1)/2) + 3
+	e := &editor{
+		a:  a,
+		b:  b,
+		vf: make([]int, size),
+		vb: make([]int, size),
+	}
+	e.compare(0, len(a), 0, len(b))
+	return groupChanges(e.out)
+}
+
+// editor holds the state of a single edits call. vf and vb hold the
+// furthest reaching paths of the forward and backward searches, by
+// diagonal, and are reused by every middleSnake call.
+type editor struct {
+	a, b   []string
+	vf, vb []int
+	out    []Line
+}
+
+// compare appends the edit script turning a[a0:a1] into b[b0:b1] to out
+func (e *editor) compare(a0, a1, b0, b1 int) {
+	for a0 < a1 && b0 < b1 && e.a[a0] == e.b[b0] {
+		e.out = append(e.out, Line{Kind: Equal, Text: e.a[a0]})
+		a0++
+		b0++
+	}
+	suffix := 0
+	for a0 < a1-suffix && b0 < b1-suffix && e.a[a1-suffix-1] == e.b[b1-suffix-1] {
+		suffix++
+	}
+	a1 -= suffix
+	b1 -= suffix
+
+	switch {
+	case a0 == a1:
+		for _, text := range e.b[b0:b1] {
+			e.out = append(e.out, Line{Kind: Insert, Text: text})
+		}
+	case b0 == b1:
+		for _, text := range e.a[a0:a1] {
+			e.out = append(e.out, Line{Kind: Delete, Text: text})
+		}
+	default:
+		x, y, u, v := e.middleSnake(a0, a1, b0, b1)
+		e.compare(a0, x, b0, y)
+		for _, text := range e.a[x:u] {
+			e.out = append(e.out, Line{Kind: Equal, Text: text})
+		}
+		e.compare(u, a1, v, b1)
+	}
+
+	for _, text := range e.a[a1 : a1+suffix] {
+		e.out = append(e.out, Line{Kind: Equal, Text: text})
+	}
+}
+
+// middleSnake searches from both ends of a[a0:a1] and b[b0:b1] at once
+// until the paths meet, and returns the start and end of the run of equal
+// lines in the middle of a shortest edit script, as positions in a and b
+func (e *editor) middleSnake(a0, a1, b0, b1 int) (x, y, u, v int) {
+	var (
+		n, m  = a1 - a0, b1 - b0
+		delta = n - m
+		odd   = delta%2 != 0
+		off   = len(e.vf) / 2
+	)
+	e.vf[off+1] = 0
+	e.vb[off+1] = 0
+
+	for d := 0; d <= (n+m+1)/2; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && e.vf[off+k-1] < e.vf[off+k+1]) {
+				x = e.vf[off+k+1]
+			} else {
+				x = e.vf[off+k-1] + 1
+			}
+			startX := x
+			for x < n && x-k < m && e.a[a0+x] == e.b[b0+x-k] {
+				x++
+			}
+			e.vf[off+k] = x
+			// The backward search has taken d-1 steps
+			if c := delta - k; odd && c >= -(d-1) && c <= d-1 && x+e.vb[off+c] >= n {
+				return a0 + startX, b0 + startX - k, a0 + x, b0 + x - k
+			}
+		}
+
+		// The backward search runs on a and b reversed, so its diagonal c
+		// is the forward diagonal delta-c
+		for c := -d; c <= d; c += 2 {
+			var x int
+			if c == -d || (c != d && e.vb[off+c-1] < e.vb[off+c+1]) {
+				x = e.vb[off+c+1]
+			} else {
+				x = e.vb[off+c-1] + 1
+			}
+			startX := x
+			for x < n && x-c < m && e.a[a1-x-1] == e.b[b1-x+c-1] {
+				x++
+			}
+			e.vb[off+c] = x
+			if k := delta - c; !odd && k >= -d && k <= d && x+e.vf[off+k] >= n {
+				return a1 - x, b1 - x + c, a1 - startX, b1 - startX + c
+			}
+		}
+	}
+	panic("diff: the searches didn't meet")
+}
+
+// groupChanges reorders each run of changes in script so that its deleted
+// lines come before its inserted ones, as diff -u does
+func groupChanges(script []Line) []Line {
+	out := make([]Line, 0, len(script))
+	for i := 0; i < len(script); {
+		if script[i].Kind == Equal {
+			out = append(out, script[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(script) && script[j].Kind != Equal {
+			j++
+		}
+		for _, kind := range []OpKind{Delete, Insert} {
+			for _, l := range script[i:j] {
+				if l.Kind == kind {
+					out = append(out, l)
+				}
+			}
+		}
+		i = j
+	}
+	return out
+}
+
+// Hunks computes the hunks of a unified diff from a to b, with the given
+// number of lines of context around each change. It returns nil if a and b
+// are identical.
+func Hunks(a, b []byte, context int) []Hunk {
+	var (
+		script  = edits(splitLines(a), splitLines(b))
+		oldPos  = make([]int, len(script))
+		newPos  = make([]int, len(script))
+		changes []int
+		hunks   []Hunk
+	)
+
+	// Record the 1 based line numbers each script entry starts at
+	oldLine, newLine := 1, 1
+	for i, l := range script {
+		oldPos[i] = oldLine
+		newPos[i] = newLine
+		if l.Kind != Insert {
+			oldLine++
+		}
+		if l.Kind != Delete {
+			newLine++
+		}
+		if l.Kind != Equal {
+			changes = append(changes, i)
+		}
+	}
+
+	for len(changes) > 0 {
+		// Changes separated by no more than two contexts worth of
+		// unchanged lines share a hunk
+		last := 0
+		for last+1 < len(changes) && changes[last+1]-changes[last]-1 <= 2*context {
+			last++
+		}
+
+		start := changes[0] - context
+		if start < 0 {
+			start = 0
+		}
+		end := changes[last] + context + 1
+		if end > len(script) {
+			end = len(script)
+		}
+
+		h := Hunk{OldStart: oldPos[start], NewStart: newPos[start]}
+		for _, l := range script[start:end] {
+			h.add(l)
+		}
+		// Empty sides refer to the line before the hunk
+		if h.OldLines == 0 {
+			h.OldStart--
+		}
+		if h.NewLines == 0 {
+			h.NewStart--
+		}
+		hunks = append(hunks, h)
+		changes = changes[last+1:]
+	}
+
+	return hunks
+}
+
+func (h *Hunk) add(l Line) {
+	h.Lines = append(h.Lines, l)
+	if l.Kind != Insert {
+		h.OldLines++
+	}
+	if l.Kind != Delete {
+		h.NewLines++
+	}
+}
+
+// Unified renders hunks as the body of a unified diff, without file headers
+func Unified(hunks []Hunk) string {
+	var sb strings.Builder
+	for _, h := range hunks {
+		sb.WriteString(h.Header())
+		sb.WriteString("\n")
+		for _, l := range h.Lines {
+			switch l.Kind {
+			case Equal:
+				sb.WriteString(" ")
+			case Insert:
+				sb.WriteString("+")
+			case Delete:
+				sb.WriteString("-")
+			}
+			sb.WriteString(l.Text)
+			if !strings.HasSuffix(l.Text, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,159 @@
+package diff
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{
+			name:     "identical",
+			a:        "one\ntwo\n",
+			b:        "one\ntwo\n",
+			expected: "",
+		},
+		{
+			name:     "new file",
+			a:        "",
+			b:        "one\ntwo\n",
+			expected: "@@ -0,0 +1,2 @@\n+one\n+two\n",
+		},
+		{
+			name:     "deleted file",
+			a:        "one\n",
+			b:        "",
+			expected: "@@ -1 +0,0 @@\n-one\n",
+		},
+		{
+			name:     "changed line with context",
+			a:        "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:        "1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			expected: "@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			name: "separate hunks",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			b:    "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n",
+			expected: "@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n" +
+				"@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+twelve\n",
+		},
+		{
+			name:     "missing newline at end of file",
+			a:        "one\ntwo",
+			b:        "one\ntwo\n",
+			expected: "@@ -1,2 +1,2 @@\n one\n-two\n\\ No newline at end of file\n+two\n",
+		},
+	}
+
+	for _, test := range tests {
+		actual := Unified(Hunks([]byte(test.a), []byte(test.b), 3))
+		if actual != test.expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestHunkCounts(t *testing.T) {
+	hunks := Hunks([]byte("1\n2\n3\n"), []byte("1\nnew\nnewer\n3\n"), 3)
+	if len(hunks) != 1 {
+		t.Fatalf("expected 1 hunk, got %d", len(hunks))
+	}
+
+	if hunks[0].Added() != 2 {
+		t.Errorf("expected 2 added lines, got %d", hunks[0].Added())
+	}
+	if hunks[0].Removed() != 1 {
+		t.Errorf("expected 1 removed line, got %d", hunks[0].Removed())
+	}
+}
+
+func TestEditsShortest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + r.Intn(4)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomLines(), randomLines()
+		script := edits(a, b)
+
+		var old, new []string
+		changes := 0
+		for _, l := range script {
+			if l.Kind != Insert {
+				old = append(old, l.Text)
+			}
+			if l.Kind != Delete {
+				new = append(new, l.Text)
+			}
+			if l.Kind != Equal {
+				changes++
+			}
+		}
+		if strings.Join(old, "") != strings.Join(a, "") || strings.Join(new, "") != strings.Join(b, "") {
+			t.Fatalf("%q to %q: script %v doesn't turn one into the other", a, b, script)
+		}
+		if expected := len(a) + len(b) - 2*lcsLength(a, b); changes != expected {
+			t.Fatalf("%q to %q: expected %d changes, got %d", a, b, expected, changes)
+		}
+	}
+}
+
+// lcsLength returns the length of the longest common subsequence of a and b
+func lcsLength(a, b []string) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] > lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	return lengths[0][0]
+}
+
+func TestEditsMemory(t *testing.T) {
+	// Every other line changes, so the script is as long as the files and
+	// keeping a frontier per step would take gigabytes
+	var a, b strings.Builder
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&a, "line %d\n", i)
+		if i%2 == 0 {
+			fmt.Fprintf(&b, "changed %d\n", i)
+		} else {
+			fmt.Fprintf(&b, "line %d\n", i)
+		}
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	hunks := Hunks([]byte(a.String()), []byte(b.String()), 3)
+	runtime.ReadMemStats(&after)
+
+	if len(hunks) != 1 || hunks[0].Added() != 2500 || hunks[0].Removed() != 2500 {
+		t.Fatalf("expected a single hunk changing 2500 lines, got %d hunks", len(hunks))
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 64<<20 {
+		t.Errorf("expected diffing to allocate under 64MB, allocated %dMB", allocated>>20)
+	}
+}
@@ -0,0 +1,93 @@
+package commitlog
+
+import (
+	"context"
+	"sync"
+
+	"commitlog/api"
+)
+
+// jobEvent is a single update on the progress of a job
+type jobEvent struct {
+	Type api.JobEvent_Type
+	// Message and Progress describe the job's progress, for STATUS events
+	Message  string
+	Progress jobProgress
+	// Step, Test and Diff describe a completed step, for STEP events
+	Step int
+	Test string
+	Diff []fileDiff
+	// Status is the final status of the job, for DONE events
+	Status *jobCacheEntry
+}
+
+// eventLog records every event of a job so that they can be replayed to
+// subscribers that arrive late, and notifies subscribers of new events
+type eventLog struct {
+	mu     sync.Mutex
+	events []jobEvent
+	closed bool
+	// changed is closed and replaced each time the log changes
+	changed chan struct{}
+}
+
+func newEventLog() *eventLog {
+	return &eventLog{changed: make(chan struct{})}
+}
+
+// add appends an event to the log. The log is closed after a DONE event, and
+// events added after that are dropped.
+func (l *eventLog) add(e jobEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return
+	}
+
+	l.events = append(l.events, e)
+	if e.Type == api.JobEvent_DONE {
+		l.closed = true
+	}
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// follow returns a channel that receives every event in the log, starting
+// from the first one. The channel is closed after the DONE event is received,
+// or when ctx is done.
+func (l *eventLog) follow(ctx context.Context) <-chan jobEvent {
+	out := make(chan jobEvent)
+
+	go func() {
+		defer close(out)
+		next := 0
+		for {
+			l.mu.Lock()
+			events := l.events[next:]
+			closed := l.closed
+			changed := l.changed
+			l.mu.Unlock()
+
+			for _, e := range events {
+				select {
+				case out <- e:
+				case <-ctx.Done():
+					return
+				}
+			}
+			next += len(events)
+
+			if closed {
+				return
+			}
+
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
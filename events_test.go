@@ -0,0 +1,43 @@
+package commitlog
+
+import (
+	"context"
+	"testing"
+
+	"commitlog/api"
+)
+
+func TestEventLog(t *testing.T) {
+	log := newEventLog()
+	log.add(jobEvent{Type: api.JobEvent_STATUS, Message: "one"})
+
+	events := log.follow(context.Background())
+	if e := <-events; e.Message != "one" {
+		t.Errorf("expected earlier event to be replayed, got: %#v", e)
+	}
+
+	log.add(jobEvent{Type: api.JobEvent_STATUS, Message: "two"})
+	if e := <-events; e.Message != "two" {
+		t.Errorf("expected new event, got: %#v", e)
+	}
+
+	log.add(jobEvent{Type: api.JobEvent_DONE})
+	log.add(jobEvent{Type: api.JobEvent_STATUS, Message: "dropped"})
+	if e := <-events; e.Type != api.JobEvent_DONE {
+		t.Errorf("expected done event, got: %#v", e)
+	}
+	if e, ok := <-events; ok {
+		t.Errorf("expected channel to be closed after done event, got: %#v", e)
+	}
+}
+
+func TestEventLog_Cancel(t *testing.T) {
+	log := newEventLog()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events := log.follow(ctx)
+	cancel()
+	if e, ok := <-events; ok {
+		t.Errorf("expected channel to be closed after cancelling, got: %#v", e)
+	}
+}
@@ -0,0 +1,174 @@
+package commitlog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"commitlog/gitcmd"
+)
+
+const finalCommitMessage = "Add remaining code"
+
+// WriteGitRepository creates a git repository in dir containing the idealized
+// log described by the job results. There is one commit per test, followed by a
+// final commit containing the full contents of every file. Files are written
+// relative to the deepest directory that contains all of them.
+// dir must either not exist or be empty.
+func WriteGitRepository(dir string, result jobResult) error {
+	if len(result.Files) == 0 {
+		return fmt.Errorf("job has no results to export")
+	}
+
+	err := createEmptyDir(dir)
+	if err != nil {
+		return err
+	}
+
+	err = gitcmd.Init(dir)
+	if err != nil {
+		return err
+	}
+
+	root := commonDir(result.Files[len(result.Files)-1])
+	written := map[string]struct{}{}
+	for i, files := range result.Files {
+		message := finalCommitMessage
+		if i < len(result.Tests) {
+			message = result.Tests[i]
+		}
+
+		current := map[string][]byte{}
+		for name, content := range files {
+			rel, err := filepath.Rel(root, name)
+			if err != nil {
+				return err
+			}
+			current[filepath.Join(dir, rel)] = content
+		}
+
+		// Files that were present in the previous step but aren't in this one
+		// need to be removed so that the commit reflects the step exactly
+		for name := range written {
+			if _, ok := current[name]; !ok {
+				err = os.Remove(name)
+				if err != nil {
+					return err
+				}
+				delete(written, name)
+			}
+		}
+
+		for name := range current {
+			err = os.MkdirAll(filepath.Dir(name), 0755)
+			if err != nil {
+				return err
+			}
+			written[name] = struct{}{}
+		}
+
+		err = writeFiles(current)
+		if err != nil {
+			return err
+		}
+
+		err = gitcmd.CommitAll(dir, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteSteps writes each step of the job results to its own numbered
+// subdirectory of dir, named after the test that produced it. The last
+// subdirectory contains the full contents of every file. Files are written
+// relative to the deepest directory that contains all of them.
+// dir must either not exist or be empty.
+func WriteSteps(dir string, result jobResult) error {
+	if len(result.Files) == 0 {
+		return fmt.Errorf("job has no results to export")
+	}
+
+	err := createEmptyDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var (
+		root  = commonDir(result.Files[len(result.Files)-1])
+		width = len(fmt.Sprint(len(result.Files)))
+	)
+	for i, files := range result.Files {
+		name := "final"
+		if i < len(result.Tests) {
+			// Subtests are named after their parent test, like TestTable/case
+			name = strings.ReplaceAll(result.Tests[i], "/", "-")
+		}
+		stepDir := filepath.Join(dir, fmt.Sprintf("%0*d-%s", width, i+1, name))
+
+		stepFiles := map[string][]byte{}
+		for name, content := range files {
+			rel, err := filepath.Rel(root, name)
+			if err != nil {
+				return err
+			}
+			stepFiles[filepath.Join(stepDir, rel)] = content
+
+			err = os.MkdirAll(filepath.Dir(filepath.Join(stepDir, rel)), 0755)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = writeFiles(stepFiles)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createEmptyDir creates dir if it doesn't exist, and returns an error
+// if it exists and contains anything
+func createEmptyDir(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("export directory %s is not empty", dir)
+	}
+
+	return os.MkdirAll(dir, 0755)
+}
+
+// commonDir returns the deepest directory containing every file in the map
+func commonDir(files map[string][]byte) string {
+	var common []string
+	first := true
+	for name := range files {
+		parts := strings.Split(filepath.Dir(filepath.Clean(name)), string(filepath.Separator))
+		if first {
+			common = parts
+			first = false
+			continue
+		}
+
+		n := 0
+		for n < len(common) && n < len(parts) && common[n] == parts[n] {
+			n++
+		}
+		common = common[:n]
+	}
+
+	dir := strings.Join(common, string(filepath.Separator))
+	if dir == "" && len(common) > 0 {
+		return string(filepath.Separator)
+	}
+	return dir
+}
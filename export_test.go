@@ -0,0 +1,138 @@
+package commitlog
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteGitRepository(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	repoDir := filepath.Join(dir, "repo")
+	result := jobResult{
+		Tests: []string{"TestOne", "TestTwo"},
+		Files: []map[string][]byte{
+			{"/src/pkg/a.go": []byte("one")},
+			{"/src/pkg/a.go": []byte("two"), "/src/pkg/sub/b.go": []byte("two")},
+			{"/src/pkg/a.go": []byte("final"), "/src/pkg/sub/b.go": []byte("final")},
+		},
+	}
+
+	err = WriteGitRepository(repoDir, result)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	out, err := exec.Command("git", "-C", repoDir, "log", "--reverse", "--format=%s").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedLog := []string{"TestOne", "TestTwo", finalCommitMessage}
+	if actualLog := strings.Split(strings.TrimSpace(string(out)), "\n"); !reflect.DeepEqual(actualLog, expectedLog) {
+		t.Errorf("unexpected commit log, got: %s, expected: %s", actualLog, expectedLog)
+	}
+
+	out, err = exec.Command("git", "-C", repoDir, "show", "HEAD~1:sub/b.go").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "two" {
+		t.Errorf("unexpected file content at second commit: %s", out)
+	}
+}
+
+func TestWriteGitRepository_NonEmptyDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "existing"), []byte("content"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WriteGitRepository(dir, jobResult{Files: []map[string][]byte{{"a.go": nil}}})
+	if err == nil {
+		t.Errorf("expected error when exporting into a non-empty directory")
+	}
+}
+
+func TestCommonDir(t *testing.T) {
+	tests := []struct {
+		files    map[string][]byte
+		expected string
+	}{
+		{
+			files:    map[string][]byte{"/a/b/c.go": nil},
+			expected: "/a/b",
+		},
+		{
+			files:    map[string][]byte{"/a/b/c.go": nil, "/a/b/d/e.go": nil},
+			expected: "/a/b",
+		},
+		{
+			files:    map[string][]byte{"/a/b/c.go": nil, "/a/d/e.go": nil},
+			expected: "/a",
+		},
+		{
+			files:    map[string][]byte{"/a/c.go": nil, "/d/e.go": nil},
+			expected: "/",
+		},
+	}
+
+	for i, test := range tests {
+		if actual := commonDir(test.files); actual != test.expected {
+			t.Errorf("case %d: expected %s, got %s", i, test.expected, actual)
+		}
+	}
+}
+
+func TestWriteSteps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	result := jobResult{
+		Tests: []string{"TestOne", "TestTable/case_one"},
+		Files: []map[string][]byte{
+			{"/src/pkg/a.go": []byte("one")},
+			{"/src/pkg/a.go": []byte("two")},
+			{"/src/pkg/a.go": []byte("final"), "/src/pkg/sub/b.go": []byte("final")},
+		},
+	}
+
+	err = WriteSteps(dir, result)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	expectedFiles := map[string]string{
+		"1-TestOne/a.go":            "one",
+		"2-TestTable-case_one/a.go": "two",
+		"3-final/a.go":              "final",
+		"3-final/sub/b.go":          "final",
+	}
+	for name, expected := range expectedFiles {
+		actual, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("failed to read step file %s: %s", name, err)
+			continue
+		}
+		if string(actual) != expected {
+			t.Errorf("unexpected content for %s, got: %s, expected: %s", name, actual, expected)
+		}
+	}
+}
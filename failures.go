@@ -0,0 +1,79 @@
+package commitlog
+
+import (
+	"fmt"
+	"time"
+
+	"commitlog/api"
+)
+
+// testOutcome records how running a test to collect its coverage ended
+type testOutcome struct {
+	Test    string
+	Outcome api.TestOutcome
+	// Output is what the test printed
+	Output   string
+	Duration time.Duration
+	// Subtests are the subtests the test ran, in the order they started
+	Subtests []string
+	// Redundant is set for tests the sort left out of the log, because
+	// the tests in it cover everything they do
+	Redundant bool
+	// Refines holds the tests of the log whose coverage the test's coverage
+	// directly contains, for CONTAINMENT sorts
+	Refines []string
+	// Chapter is the title of the chapter of the log the test is in, for
+	// CHAPTERS sorts
+	Chapter string
+	// Metrics describes what the test adds at its step of the log, and is
+	// nil for tests left out of it
+	Metrics *testMetrics
+}
+
+// newTestOutcome returns the outcome of test reported by a test runner. A
+// nil result means the test passed, with nothing else known about it.
+func newTestOutcome(test string, result *api.TestResult) testOutcome {
+	return testOutcome{
+		Test:     test,
+		Outcome:  result.GetOutcome(),
+		Output:   result.GetOutput(),
+		Duration: time.Duration(result.GetDurationMs()) * time.Millisecond,
+		Subtests: result.GetSubtests(),
+	}
+}
+
+var outcomeDescriptions = map[api.TestOutcome]string{
+	api.TestOutcome_FAIL:        "failed",
+	api.TestOutcome_SKIP:        "was skipped",
+	api.TestOutcome_BUILD_ERROR: "failed to build",
+	api.TestOutcome_TIMEOUT:     "timed out",
+}
+
+// err returns the error failing a job because of the outcome
+func (o testOutcome) err() error {
+	return fmt.Errorf("%s %s:\n%s", o.Test, outcomeDescriptions[o.Outcome], o.Output)
+}
+
+// applyFailurePolicy returns whether a test with the given outcome belongs in
+// the log under policy, or an error if the job should fail because of it.
+// Skipped tests never fail a job, they're only left out by DROP_FAILING.
+// Tests that don't build fail the job under every policy.
+func applyFailurePolicy(policy api.StartJobRequest_FailurePolicy, o testOutcome) (bool, error) {
+	switch {
+	case o.Outcome == api.TestOutcome_PASS:
+		return true, nil
+	case o.Outcome == api.TestOutcome_BUILD_ERROR:
+		return false, o.err()
+	}
+
+	switch policy {
+	case api.StartJobRequest_KEEP_PARTIAL:
+		return true, nil
+	case api.StartJobRequest_DROP_FAILING:
+		return false, nil
+	}
+	if o.Outcome == api.TestOutcome_SKIP {
+		return true, nil
+	}
+	return false, o.err()
+}
@@ -0,0 +1,174 @@
+package commitlog
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"commitlog/api"
+	memCache "commitlog/cache"
+
+	"golang.org/x/tools/cover"
+)
+
+// mockFailingRunner reports the given outcome for some tests, along with the
+// coverage in testdata
+type mockFailingRunner struct {
+	mockFileRunner
+	outcomes map[string]api.TestOutcome
+}
+
+func (m mockFailingRunner) GetCoverage(ctx context.Context, pkg string, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
+	profiles, _, err := m.mockFileRunner.GetCoverage(ctx, pkg, test, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	outcome, ok := m.outcomes[test]
+	if !ok {
+		return profiles, nil, nil
+	}
+	return profiles, &api.TestResult{Test: test, Outcome: outcome, Output: "output of " + test}, nil
+}
+
+func TestApplyFailurePolicy(t *testing.T) {
+	tests := []struct {
+		policy  api.StartJobRequest_FailurePolicy
+		outcome api.TestOutcome
+		keep    bool
+		fails   bool
+	}{
+		{api.StartJobRequest_FAIL_FAST, api.TestOutcome_PASS, true, false},
+		{api.StartJobRequest_FAIL_FAST, api.TestOutcome_FAIL, false, true},
+		{api.StartJobRequest_FAIL_FAST, api.TestOutcome_TIMEOUT, false, true},
+		{api.StartJobRequest_FAIL_FAST, api.TestOutcome_SKIP, true, false},
+		{api.StartJobRequest_FAIL_FAST, api.TestOutcome_BUILD_ERROR, false, true},
+		{api.StartJobRequest_DROP_FAILING, api.TestOutcome_PASS, true, false},
+		{api.StartJobRequest_DROP_FAILING, api.TestOutcome_FAIL, false, false},
+		{api.StartJobRequest_DROP_FAILING, api.TestOutcome_TIMEOUT, false, false},
+		{api.StartJobRequest_DROP_FAILING, api.TestOutcome_SKIP, false, false},
+		{api.StartJobRequest_DROP_FAILING, api.TestOutcome_BUILD_ERROR, false, true},
+		{api.StartJobRequest_KEEP_PARTIAL, api.TestOutcome_FAIL, true, false},
+		{api.StartJobRequest_KEEP_PARTIAL, api.TestOutcome_TIMEOUT, true, false},
+		{api.StartJobRequest_KEEP_PARTIAL, api.TestOutcome_SKIP, true, false},
+		{api.StartJobRequest_KEEP_PARTIAL, api.TestOutcome_BUILD_ERROR, false, true},
+	}
+
+	for _, tt := range tests {
+		keep, err := applyFailurePolicy(tt.policy, testOutcome{Test: "TestOne", Outcome: tt.outcome})
+		if keep != tt.keep || (err != nil) != tt.fails {
+			t.Errorf("%s %s: expected keep %t and error %t, got %t and %v", tt.policy, tt.outcome, tt.keep, tt.fails, keep, err)
+		}
+	}
+}
+
+func TestComputeFileContentsByTest_FailurePolicy(t *testing.T) {
+	tests := []string{"TestFuncOne", "TestFuncTwo", "TestFuncThree"}
+	runner := mockFailingRunner{outcomes: map[string]api.TestOutcome{"TestFuncTwo": api.TestOutcome_FAIL}}
+	compute := func(policy api.StartJobRequest_FailurePolicy) ([]string, []map[string][]byte, []testOutcome, error) {
+		return computeFileContentsByTest(computationConfig{
+			ctx:               context.Background(),
+			testCoverageCache: memCache.New(),
+			progress:          newProgressTracker(func(jobProgress) {}),
+			runner:            runner,
+			JobConfig: JobConfig{
+				pkg:           "testdata",
+				tests:         tests,
+				sort:          sortWithMetrics(sortHardcodedOrder(tests)),
+				failurePolicy: policy,
+			},
+		})
+	}
+
+	_, _, _, err := compute(api.StartJobRequest_FAIL_FAST)
+	if err == nil || !strings.Contains(err.Error(), "TestFuncTwo failed") || !strings.Contains(err.Error(), "output of TestFuncTwo") {
+		t.Errorf("expected TestFuncTwo to fail the job, got: %v", err)
+	}
+
+	// Tests left out of the log have no metrics
+	expectedOutcomes := []testOutcome{
+		{Test: "TestFuncOne", Metrics: &testMetrics{LinesCovered: 3, NewLines: 3, Importance: 1}},
+		{Test: "TestFuncTwo", Outcome: api.TestOutcome_FAIL, Output: "output of TestFuncTwo"},
+		{Test: "TestFuncThree", Metrics: &testMetrics{LinesCovered: 5, NewLines: 5, Importance: 1}},
+	}
+
+	sorted, files, outcomes, err := compute(api.StartJobRequest_DROP_FAILING)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"TestFuncOne", "TestFuncThree"}; !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected tests %v, got %v", expected, sorted)
+	}
+	if len(files) != 3 {
+		t.Errorf("expected 3 steps, got %d", len(files))
+	}
+	if !reflect.DeepEqual(outcomes, expectedOutcomes) {
+		t.Errorf("expected outcomes %+v, got %+v", expectedOutcomes, outcomes)
+	}
+
+	sorted, _, outcomes, err = compute(api.StartJobRequest_KEEP_PARTIAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedOutcomes[1].Metrics = &testMetrics{LinesCovered: 4, NewLines: 4, Importance: 1}
+	if !reflect.DeepEqual(sorted, tests) {
+		t.Errorf("expected tests %v, got %v", tests, sorted)
+	}
+	if !reflect.DeepEqual(outcomes, expectedOutcomes) {
+		t.Errorf("expected outcomes %+v, got %+v", expectedOutcomes, outcomes)
+	}
+
+	runner.outcomes["TestFuncTwo"] = api.TestOutcome_BUILD_ERROR
+	_, _, _, err = compute(api.StartJobRequest_KEEP_PARTIAL)
+	if err == nil || !strings.Contains(err.Error(), "TestFuncTwo failed to build") {
+		t.Errorf("expected the build error to fail the job, got: %v", err)
+	}
+}
+
+func TestGetTestProfiles_DoesNotCacheFailures(t *testing.T) {
+	testCache := memCache.New()
+	runner := mockFailingRunner{outcomes: map[string]api.TestOutcome{"TestFuncOne": api.TestOutcome_FAIL}}
+
+	_, result, err := getTestProfiles(context.Background(), "testdata", "TestFuncOne", nil, runner, testCache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Outcome != api.TestOutcome_FAIL {
+		t.Errorf("expected the test to fail, got %s", result.Outcome)
+	}
+
+	key, err := cacheKeyForTest("testdata", "TestFuncOne", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testCache.Read(key) != nil {
+		t.Errorf("expected the coverage of a failing test not to be cached")
+	}
+}
+
+func TestComputeFileContentsByTest_RedundantTests(t *testing.T) {
+	tests := []string{"TestFuncOne", "TestFuncTwo", "TestFuncThree"}
+	sorted, files, outcomes, err := computeFileContentsByTest(computationConfig{
+		ctx:               context.Background(),
+		testCoverageCache: memCache.New(),
+		progress:          newProgressTracker(func(jobProgress) {}),
+		runner:            mockFileRunner{},
+		JobConfig: JobConfig{
+			pkg:   "testdata",
+			tests: tests,
+			sort:  sortHardcodedOrder([]string{"TestFuncOne", "TestFuncThree"}),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"TestFuncOne", "TestFuncThree"}; !reflect.DeepEqual(sorted, expected) || len(files) != 3 {
+		t.Errorf("expected tests %v and 3 steps, got %v and %d steps", expected, sorted, len(files))
+	}
+	for _, o := range outcomes {
+		if o.Redundant != (o.Test == "TestFuncTwo") {
+			t.Errorf("%s: unexpected redundant %t", o.Test, o.Redundant)
+		}
+	}
+}
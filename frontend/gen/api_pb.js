@@ -15,13 +15,36 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = Function('return this')();
 
+goog.exportSymbol('proto.Chapter', null, global);
 goog.exportSymbol('proto.CheckoutFilesRequest', null, global);
+goog.exportSymbol('proto.ExportRepositoryRequest', null, global);
+goog.exportSymbol('proto.FileDiff', null, global);
 goog.exportSymbol('proto.FileMap', null, global);
+goog.exportSymbol('proto.JobEvent', null, global);
+goog.exportSymbol('proto.JobEvent.Type', null, global);
+goog.exportSymbol('proto.JobMetadata', null, global);
+goog.exportSymbol('proto.JobProgress', null, global);
+goog.exportSymbol('proto.JobProgress.Phase', null, global);
 goog.exportSymbol('proto.JobResults', null, global);
 goog.exportSymbol('proto.JobStatusResponse', null, global);
+goog.exportSymbol('proto.JobSummary', null, global);
+goog.exportSymbol('proto.JobSummary.State', null, global);
+goog.exportSymbol('proto.ListJobsResponse', null, global);
+goog.exportSymbol('proto.ListTestsRequest', null, global);
+goog.exportSymbol('proto.SortConstraints', null, global);
+goog.exportSymbol('proto.SortConstraints.Order', null, global);
+goog.exportSymbol('proto.SortConstraints.Pin', null, global);
 goog.exportSymbol('proto.StartJobRequest', null, global);
+goog.exportSymbol('proto.StartJobRequest.FailurePolicy', null, global);
 goog.exportSymbol('proto.StartJobRequest.SortType', null, global);
 goog.exportSymbol('proto.StartJobResponse', null, global);
+goog.exportSymbol('proto.StepDiff', null, global);
+goog.exportSymbol('proto.TestKind', null, global);
+goog.exportSymbol('proto.TestMetrics', null, global);
+goog.exportSymbol('proto.TestOptions', null, global);
+goog.exportSymbol('proto.TestOutcome', null, global);
+goog.exportSymbol('proto.TestRefinement', null, global);
+goog.exportSymbol('proto.TestResult', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -43,6 +66,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.StartJobRequest.displayName = 'proto.StartJobRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SortConstraints = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.SortConstraints.repeatedFields_, null);
+};
+goog.inherits(proto.SortConstraints, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SortConstraints.displayName = 'proto.SortConstraints';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SortConstraints.Pin = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SortConstraints.Pin, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SortConstraints.Pin.displayName = 'proto.SortConstraints.Pin';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.SortConstraints.Order = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.SortConstraints.Order, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.SortConstraints.Order.displayName = 'proto.SortConstraints.Order';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestOptions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.TestOptions.repeatedFields_, null);
+};
+goog.inherits(proto.TestOptions, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestOptions.displayName = 'proto.TestOptions';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -86,75 +193,4620 @@ if (goog.DEBUG && !COMPILED) {
   proto.CheckoutFilesRequest.displayName = 'proto.CheckoutFilesRequest';
 }
 /**
- * Generated by JsPbCodeGenerator.
- * @param {Array=} opt_data Optional initial data array, typically from a
- * server response, or constructed directly in Javascript. The array is used
- * in place and becomes part of the constructed object. It is not cloned.
- * If no data is provided, the constructed object will be empty, but still
- * valid.
- * @extends {jspb.Message}
- * @constructor
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ExportRepositoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ExportRepositoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ExportRepositoryRequest.displayName = 'proto.ExportRepositoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ListTestsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.ListTestsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ListTestsRequest.displayName = 'proto.ListTestsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.JobStatusResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.JobStatusResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.JobStatusResponse.displayName = 'proto.JobStatusResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.JobMetadata = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.JobMetadata.repeatedFields_, null);
+};
+goog.inherits(proto.JobMetadata, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.JobMetadata.displayName = 'proto.JobMetadata';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.JobSummary = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.JobSummary, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.JobSummary.displayName = 'proto.JobSummary';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.ListJobsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.ListJobsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.ListJobsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.ListJobsResponse.displayName = 'proto.ListJobsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.JobProgress = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.JobProgress, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.JobProgress.displayName = 'proto.JobProgress';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.JobResults = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.JobResults.repeatedFields_, null);
+};
+goog.inherits(proto.JobResults, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.JobResults.displayName = 'proto.JobResults';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestMetrics = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.TestMetrics, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestMetrics.displayName = 'proto.TestMetrics';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.Chapter = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.Chapter.repeatedFields_, null);
+};
+goog.inherits(proto.Chapter, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.Chapter.displayName = 'proto.Chapter';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestRefinement = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.TestRefinement.repeatedFields_, null);
+};
+goog.inherits(proto.TestRefinement, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestRefinement.displayName = 'proto.TestRefinement';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.TestResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.TestResult.repeatedFields_, null);
+};
+goog.inherits(proto.TestResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.TestResult.displayName = 'proto.TestResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.StepDiff = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.StepDiff.repeatedFields_, null);
+};
+goog.inherits(proto.StepDiff, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.StepDiff.displayName = 'proto.StepDiff';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.FileDiff = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.FileDiff, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.FileDiff.displayName = 'proto.FileDiff';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.JobEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.JobEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.JobEvent.displayName = 'proto.JobEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.FileMap = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.FileMap, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.FileMap.displayName = 'proto.FileMap';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.StartJobRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.StartJobRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.StartJobRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.StartJobRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.StartJobRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    testsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    pkg: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    sort: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    options: (f = msg.getOptions()) && proto.TestOptions.toObject(includeInstance, f),
+    failurePolicy: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    constraints: (f = msg.getConstraints()) && proto.SortConstraints.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.StartJobRequest}
+ */
+proto.StartJobRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.StartJobRequest;
+  return proto.StartJobRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.StartJobRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.StartJobRequest}
+ */
+proto.StartJobRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTests(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPkg(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.StartJobRequest.SortType} */ (reader.readEnum());
+      msg.setSort(value);
+      break;
+    case 4:
+      var value = new proto.TestOptions;
+      reader.readMessage(value,proto.TestOptions.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.StartJobRequest.FailurePolicy} */ (reader.readEnum());
+      msg.setFailurePolicy(value);
+      break;
+    case 6:
+      var value = new proto.SortConstraints;
+      reader.readMessage(value,proto.SortConstraints.deserializeBinaryFromReader);
+      msg.setConstraints(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.StartJobRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.StartJobRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.StartJobRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.StartJobRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getPkg();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSort();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.TestOptions.serializeBinaryToWriter
+    );
+  }
+  f = message.getFailurePolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getConstraints();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.SortConstraints.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.StartJobRequest.SortType = {
+  HARDCODED: 0,
+  RAW: 1,
+  NET: 2,
+  IMPORTANCE: 3,
+  DURATION: 4,
+  FREQUENCY: 5,
+  MINIMAL: 6,
+  CALLGRAPH: 7,
+  CONTAINMENT: 8,
+  CHAPTERS: 9
+};
+
+/**
+ * @enum {number}
+ */
+proto.StartJobRequest.FailurePolicy = {
+  FAIL_FAST: 0,
+  DROP_FAILING: 1,
+  KEEP_PARTIAL: 2
+};
+
+/**
+ * repeated string tests = 1;
+ * @return {!Array<string>}
+ */
+proto.StartJobRequest.prototype.getTestsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.setTestsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.addTests = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.clearTestsList = function() {
+  return this.setTestsList([]);
+};
+
+
+/**
+ * optional string pkg = 2;
+ * @return {string}
+ */
+proto.StartJobRequest.prototype.getPkg = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.setPkg = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional SortType sort = 3;
+ * @return {!proto.StartJobRequest.SortType}
+ */
+proto.StartJobRequest.prototype.getSort = function() {
+  return /** @type {!proto.StartJobRequest.SortType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.StartJobRequest.SortType} value
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.setSort = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional TestOptions options = 4;
+ * @return {?proto.TestOptions}
+ */
+proto.StartJobRequest.prototype.getOptions = function() {
+  return /** @type{?proto.TestOptions} */ (
+    jspb.Message.getWrapperField(this, proto.TestOptions, 4));
+};
+
+
+/**
+ * @param {?proto.TestOptions|undefined} value
+ * @return {!proto.StartJobRequest} returns this
+*/
+proto.StartJobRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.StartJobRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional FailurePolicy failure_policy = 5;
+ * @return {!proto.StartJobRequest.FailurePolicy}
+ */
+proto.StartJobRequest.prototype.getFailurePolicy = function() {
+  return /** @type {!proto.StartJobRequest.FailurePolicy} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.StartJobRequest.FailurePolicy} value
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.setFailurePolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional SortConstraints constraints = 6;
+ * @return {?proto.SortConstraints}
+ */
+proto.StartJobRequest.prototype.getConstraints = function() {
+  return /** @type{?proto.SortConstraints} */ (
+    jspb.Message.getWrapperField(this, proto.SortConstraints, 6));
+};
+
+
+/**
+ * @param {?proto.SortConstraints|undefined} value
+ * @return {!proto.StartJobRequest} returns this
+*/
+proto.StartJobRequest.prototype.setConstraints = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.StartJobRequest} returns this
+ */
+proto.StartJobRequest.prototype.clearConstraints = function() {
+  return this.setConstraints(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.StartJobRequest.prototype.hasConstraints = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.SortConstraints.repeatedFields_ = [1,2,3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SortConstraints.prototype.toObject = function(opt_includeInstance) {
+  return proto.SortConstraints.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SortConstraints} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SortConstraints.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    firstList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    lastList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    pinsList: jspb.Message.toObjectList(msg.getPinsList(),
+    proto.SortConstraints.Pin.toObject, includeInstance),
+    ordersList: jspb.Message.toObjectList(msg.getOrdersList(),
+    proto.SortConstraints.Order.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SortConstraints}
+ */
+proto.SortConstraints.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SortConstraints;
+  return proto.SortConstraints.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SortConstraints} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SortConstraints}
+ */
+proto.SortConstraints.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFirst(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLast(value);
+      break;
+    case 3:
+      var value = new proto.SortConstraints.Pin;
+      reader.readMessage(value,proto.SortConstraints.Pin.deserializeBinaryFromReader);
+      msg.addPins(value);
+      break;
+    case 4:
+      var value = new proto.SortConstraints.Order;
+      reader.readMessage(value,proto.SortConstraints.Order.deserializeBinaryFromReader);
+      msg.addOrders(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SortConstraints.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SortConstraints.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SortConstraints} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SortConstraints.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFirstList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getLastList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getPinsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.SortConstraints.Pin.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrdersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.SortConstraints.Order.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SortConstraints.Pin.prototype.toObject = function(opt_includeInstance) {
+  return proto.SortConstraints.Pin.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SortConstraints.Pin} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SortConstraints.Pin.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    test: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    step: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SortConstraints.Pin}
+ */
+proto.SortConstraints.Pin.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SortConstraints.Pin;
+  return proto.SortConstraints.Pin.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SortConstraints.Pin} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SortConstraints.Pin}
+ */
+proto.SortConstraints.Pin.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTest(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStep(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SortConstraints.Pin.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SortConstraints.Pin.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SortConstraints.Pin} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SortConstraints.Pin.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTest();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStep();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string test = 1;
+ * @return {string}
+ */
+proto.SortConstraints.Pin.prototype.getTest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SortConstraints.Pin} returns this
+ */
+proto.SortConstraints.Pin.prototype.setTest = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 step = 2;
+ * @return {number}
+ */
+proto.SortConstraints.Pin.prototype.getStep = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.SortConstraints.Pin} returns this
+ */
+proto.SortConstraints.Pin.prototype.setStep = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.SortConstraints.Order.prototype.toObject = function(opt_includeInstance) {
+  return proto.SortConstraints.Order.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.SortConstraints.Order} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SortConstraints.Order.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    before: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    after: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.SortConstraints.Order}
+ */
+proto.SortConstraints.Order.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.SortConstraints.Order;
+  return proto.SortConstraints.Order.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.SortConstraints.Order} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.SortConstraints.Order}
+ */
+proto.SortConstraints.Order.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBefore(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAfter(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.SortConstraints.Order.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.SortConstraints.Order.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.SortConstraints.Order} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.SortConstraints.Order.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getBefore();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAfter();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string before = 1;
+ * @return {string}
+ */
+proto.SortConstraints.Order.prototype.getBefore = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SortConstraints.Order} returns this
+ */
+proto.SortConstraints.Order.prototype.setBefore = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string after = 2;
+ * @return {string}
+ */
+proto.SortConstraints.Order.prototype.getAfter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.SortConstraints.Order} returns this
+ */
+proto.SortConstraints.Order.prototype.setAfter = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string first = 1;
+ * @return {!Array<string>}
+ */
+proto.SortConstraints.prototype.getFirstList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.setFirstList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.addFirst = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.clearFirstList = function() {
+  return this.setFirstList([]);
+};
+
+
+/**
+ * repeated string last = 2;
+ * @return {!Array<string>}
+ */
+proto.SortConstraints.prototype.getLastList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.setLastList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.addLast = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.clearLastList = function() {
+  return this.setLastList([]);
+};
+
+
+/**
+ * repeated Pin pins = 3;
+ * @return {!Array<!proto.SortConstraints.Pin>}
+ */
+proto.SortConstraints.prototype.getPinsList = function() {
+  return /** @type{!Array<!proto.SortConstraints.Pin>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SortConstraints.Pin, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.SortConstraints.Pin>} value
+ * @return {!proto.SortConstraints} returns this
+*/
+proto.SortConstraints.prototype.setPinsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.SortConstraints.Pin=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SortConstraints.Pin}
+ */
+proto.SortConstraints.prototype.addPins = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.SortConstraints.Pin, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.clearPinsList = function() {
+  return this.setPinsList([]);
+};
+
+
+/**
+ * repeated Order orders = 4;
+ * @return {!Array<!proto.SortConstraints.Order>}
+ */
+proto.SortConstraints.prototype.getOrdersList = function() {
+  return /** @type{!Array<!proto.SortConstraints.Order>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.SortConstraints.Order, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.SortConstraints.Order>} value
+ * @return {!proto.SortConstraints} returns this
+*/
+proto.SortConstraints.prototype.setOrdersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.SortConstraints.Order=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.SortConstraints.Order}
+ */
+proto.SortConstraints.prototype.addOrders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.SortConstraints.Order, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.SortConstraints} returns this
+ */
+proto.SortConstraints.prototype.clearOrdersList = function() {
+  return this.setOrdersList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.TestOptions.repeatedFields_ = [1,2,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.TestOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.TestOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    coverPkgsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    tagsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    envMap: (f = msg.getEnvMap()) ? f.toObject(includeInstance, undefined) : [],
+    timeout: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    pb_short: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    count: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    argsList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    coverMode: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.TestOptions}
+ */
+proto.TestOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.TestOptions;
+  return proto.TestOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.TestOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.TestOptions}
+ */
+proto.TestOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCoverPkgs(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTags(value);
+      break;
+    case 3:
+      var value = msg.getEnvMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShort(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCount(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addArgs(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCoverMode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.TestOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.TestOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.TestOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestOptions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCoverPkgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTagsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getEnvMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getShort();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      6,
+      f
+    );
+  }
+  f = message.getArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      7,
+      f
+    );
+  }
+  f = message.getCoverMode();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string cover_pkgs = 1;
+ * @return {!Array<string>}
+ */
+proto.TestOptions.prototype.getCoverPkgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setCoverPkgsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.addCoverPkgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.clearCoverPkgsList = function() {
+  return this.setCoverPkgsList([]);
+};
+
+
+/**
+ * repeated string tags = 2;
+ * @return {!Array<string>}
+ */
+proto.TestOptions.prototype.getTagsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setTagsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.addTags = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.clearTagsList = function() {
+  return this.setTagsList([]);
+};
+
+
+/**
+ * map<string, string> env = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.TestOptions.prototype.getEnvMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.clearEnvMap = function() {
+  this.getEnvMap().clear();
+  return this;};
+
+
+/**
+ * optional string timeout = 4;
+ * @return {string}
+ */
+proto.TestOptions.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool short = 5;
+ * @return {boolean}
+ */
+proto.TestOptions.prototype.getShort = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setShort = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional int32 count = 6;
+ * @return {number}
+ */
+proto.TestOptions.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * repeated string args = 7;
+ * @return {!Array<string>}
+ */
+proto.TestOptions.prototype.getArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setArgsList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.addArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.clearArgsList = function() {
+  return this.setArgsList([]);
+};
+
+
+/**
+ * optional string cover_mode = 8;
+ * @return {string}
+ */
+proto.TestOptions.prototype.getCoverMode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestOptions} returns this
+ */
+proto.TestOptions.prototype.setCoverMode = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.StartJobResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.StartJobResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.StartJobResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.StartJobResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.StartJobResponse}
+ */
+proto.StartJobResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.StartJobResponse;
+  return proto.StartJobResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.StartJobResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.StartJobResponse}
+ */
+proto.StartJobResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.StartJobResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.StartJobResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.StartJobResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.StartJobResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.StartJobResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.StartJobResponse} returns this
+ */
+proto.StartJobResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.CheckoutFilesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.CheckoutFilesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.CheckoutFilesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CheckoutFilesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    files: (f = msg.getFiles()) && proto.FileMap.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.CheckoutFilesRequest}
+ */
+proto.CheckoutFilesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.CheckoutFilesRequest;
+  return proto.CheckoutFilesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.CheckoutFilesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.CheckoutFilesRequest}
+ */
+proto.CheckoutFilesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.FileMap;
+      reader.readMessage(value,proto.FileMap.deserializeBinaryFromReader);
+      msg.setFiles(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.CheckoutFilesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.CheckoutFilesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.CheckoutFilesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.CheckoutFilesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFiles();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.FileMap.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional FileMap files = 1;
+ * @return {?proto.FileMap}
+ */
+proto.CheckoutFilesRequest.prototype.getFiles = function() {
+  return /** @type{?proto.FileMap} */ (
+    jspb.Message.getWrapperField(this, proto.FileMap, 1));
+};
+
+
+/**
+ * @param {?proto.FileMap|undefined} value
+ * @return {!proto.CheckoutFilesRequest} returns this
+*/
+proto.CheckoutFilesRequest.prototype.setFiles = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.CheckoutFilesRequest} returns this
+ */
+proto.CheckoutFilesRequest.prototype.clearFiles = function() {
+  return this.setFiles(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.CheckoutFilesRequest.prototype.hasFiles = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ExportRepositoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ExportRepositoryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ExportRepositoryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ExportRepositoryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    dir: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ExportRepositoryRequest}
+ */
+proto.ExportRepositoryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ExportRepositoryRequest;
+  return proto.ExportRepositoryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ExportRepositoryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ExportRepositoryRequest}
+ */
+proto.ExportRepositoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDir(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ExportRepositoryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ExportRepositoryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ExportRepositoryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ExportRepositoryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDir();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string dir = 1;
+ * @return {string}
+ */
+proto.ExportRepositoryRequest.prototype.getDir = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ExportRepositoryRequest} returns this
+ */
+proto.ExportRepositoryRequest.prototype.setDir = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ListTestsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.ListTestsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ListTestsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ListTestsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pkg: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    options: (f = msg.getOptions()) && proto.TestOptions.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ListTestsRequest}
+ */
+proto.ListTestsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ListTestsRequest;
+  return proto.ListTestsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ListTestsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ListTestsRequest}
+ */
+proto.ListTestsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPkg(value);
+      break;
+    case 2:
+      var value = new proto.TestOptions;
+      reader.readMessage(value,proto.TestOptions.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ListTestsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ListTestsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ListTestsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ListTestsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPkg();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.TestOptions.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string pkg = 1;
+ * @return {string}
+ */
+proto.ListTestsRequest.prototype.getPkg = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.ListTestsRequest} returns this
+ */
+proto.ListTestsRequest.prototype.setPkg = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional TestOptions options = 2;
+ * @return {?proto.TestOptions}
+ */
+proto.ListTestsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.TestOptions} */ (
+    jspb.Message.getWrapperField(this, proto.TestOptions, 2));
+};
+
+
+/**
+ * @param {?proto.TestOptions|undefined} value
+ * @return {!proto.ListTestsRequest} returns this
+*/
+proto.ListTestsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.ListTestsRequest} returns this
+ */
+proto.ListTestsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.ListTestsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.JobStatusResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.JobStatusResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.JobStatusResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobStatusResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    complete: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    details: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    results: (f = msg.getResults()) && proto.JobResults.toObject(includeInstance, f),
+    cancelled: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    progress: (f = msg.getProgress()) && proto.JobProgress.toObject(includeInstance, f),
+    metadata: (f = msg.getMetadata()) && proto.JobMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.JobStatusResponse}
+ */
+proto.JobStatusResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.JobStatusResponse;
+  return proto.JobStatusResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.JobStatusResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.JobStatusResponse}
+ */
+proto.JobStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setComplete(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDetails(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    case 4:
+      var value = new proto.JobResults;
+      reader.readMessage(value,proto.JobResults.deserializeBinaryFromReader);
+      msg.setResults(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCancelled(value);
+      break;
+    case 6:
+      var value = new proto.JobProgress;
+      reader.readMessage(value,proto.JobProgress.deserializeBinaryFromReader);
+      msg.setProgress(value);
+      break;
+    case 7:
+      var value = new proto.JobMetadata;
+      reader.readMessage(value,proto.JobMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.JobStatusResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.JobStatusResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.JobStatusResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobStatusResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getComplete();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getDetails();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getResults();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.JobResults.serializeBinaryToWriter
+    );
+  }
+  f = message.getCancelled();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getProgress();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.JobProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.JobMetadata.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional bool complete = 1;
+ * @return {boolean}
+ */
+proto.JobStatusResponse.prototype.getComplete = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.setComplete = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string details = 2;
+ * @return {string}
+ */
+proto.JobStatusResponse.prototype.getDetails = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.setDetails = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string error = 3;
+ * @return {string}
+ */
+proto.JobStatusResponse.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional JobResults results = 4;
+ * @return {?proto.JobResults}
+ */
+proto.JobStatusResponse.prototype.getResults = function() {
+  return /** @type{?proto.JobResults} */ (
+    jspb.Message.getWrapperField(this, proto.JobResults, 4));
+};
+
+
+/**
+ * @param {?proto.JobResults|undefined} value
+ * @return {!proto.JobStatusResponse} returns this
+*/
+proto.JobStatusResponse.prototype.setResults = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.clearResults = function() {
+  return this.setResults(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.JobStatusResponse.prototype.hasResults = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional bool cancelled = 5;
+ * @return {boolean}
+ */
+proto.JobStatusResponse.prototype.getCancelled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.setCancelled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional JobProgress progress = 6;
+ * @return {?proto.JobProgress}
+ */
+proto.JobStatusResponse.prototype.getProgress = function() {
+  return /** @type{?proto.JobProgress} */ (
+    jspb.Message.getWrapperField(this, proto.JobProgress, 6));
+};
+
+
+/**
+ * @param {?proto.JobProgress|undefined} value
+ * @return {!proto.JobStatusResponse} returns this
+*/
+proto.JobStatusResponse.prototype.setProgress = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.clearProgress = function() {
+  return this.setProgress(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.JobStatusResponse.prototype.hasProgress = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional JobMetadata metadata = 7;
+ * @return {?proto.JobMetadata}
+ */
+proto.JobStatusResponse.prototype.getMetadata = function() {
+  return /** @type{?proto.JobMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.JobMetadata, 7));
+};
+
+
+/**
+ * @param {?proto.JobMetadata|undefined} value
+ * @return {!proto.JobStatusResponse} returns this
+*/
+proto.JobStatusResponse.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.JobStatusResponse} returns this
+ */
+proto.JobStatusResponse.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.JobStatusResponse.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.JobMetadata.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.JobMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.JobMetadata.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.JobMetadata} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobMetadata.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pkg: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sort: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    testsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    createdAtMs: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    finishedAtMs: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.JobMetadata}
+ */
+proto.JobMetadata.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.JobMetadata;
+  return proto.JobMetadata.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.JobMetadata} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.JobMetadata}
+ */
+proto.JobMetadata.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPkg(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.StartJobRequest.SortType} */ (reader.readEnum());
+      msg.setSort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTests(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAtMs(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFinishedAtMs(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDurationMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.JobMetadata.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.JobMetadata.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.JobMetadata} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobMetadata.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPkg();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSort();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getTestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getCreatedAtMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getFinishedAtMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getDurationMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string pkg = 1;
+ * @return {string}
+ */
+proto.JobMetadata.prototype.getPkg = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.setPkg = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional SortType sort = 2;
+ * @return {!proto.StartJobRequest.SortType}
+ */
+proto.JobMetadata.prototype.getSort = function() {
+  return /** @type {!proto.StartJobRequest.SortType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.StartJobRequest.SortType} value
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.setSort = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * repeated string tests = 3;
+ * @return {!Array<string>}
+ */
+proto.JobMetadata.prototype.getTestsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.setTestsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.addTests = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.clearTestsList = function() {
+  return this.setTestsList([]);
+};
+
+
+/**
+ * optional int64 created_at_ms = 4;
+ * @return {number}
+ */
+proto.JobMetadata.prototype.getCreatedAtMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.setCreatedAtMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 finished_at_ms = 5;
+ * @return {number}
+ */
+proto.JobMetadata.prototype.getFinishedAtMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.setFinishedAtMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 duration_ms = 6;
+ * @return {number}
+ */
+proto.JobMetadata.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobMetadata} returns this
+ */
+proto.JobMetadata.prototype.setDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.JobSummary.prototype.toObject = function(opt_includeInstance) {
+  return proto.JobSummary.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.JobSummary} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobSummary.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    state: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    details: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    metadata: (f = msg.getMetadata()) && proto.JobMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.JobSummary}
+ */
+proto.JobSummary.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.JobSummary;
+  return proto.JobSummary.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.JobSummary} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.JobSummary}
+ */
+proto.JobSummary.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.JobSummary.State} */ (reader.readEnum());
+      msg.setState(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDetails(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    case 5:
+      var value = new proto.JobMetadata;
+      reader.readMessage(value,proto.JobMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.JobSummary.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.JobSummary.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.JobSummary} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobSummary.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getState();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getDetails();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.JobMetadata.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.JobSummary.State = {
+  RUNNING: 0,
+  COMPLETE: 1,
+  FAILED: 2,
+  CANCELLED: 3
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.JobSummary.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobSummary} returns this
+ */
+proto.JobSummary.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional State state = 2;
+ * @return {!proto.JobSummary.State}
+ */
+proto.JobSummary.prototype.getState = function() {
+  return /** @type {!proto.JobSummary.State} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.JobSummary.State} value
+ * @return {!proto.JobSummary} returns this
+ */
+proto.JobSummary.prototype.setState = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string details = 3;
+ * @return {string}
+ */
+proto.JobSummary.prototype.getDetails = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobSummary} returns this
+ */
+proto.JobSummary.prototype.setDetails = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string error = 4;
+ * @return {string}
+ */
+proto.JobSummary.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobSummary} returns this
+ */
+proto.JobSummary.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional JobMetadata metadata = 5;
+ * @return {?proto.JobMetadata}
+ */
+proto.JobSummary.prototype.getMetadata = function() {
+  return /** @type{?proto.JobMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.JobMetadata, 5));
+};
+
+
+/**
+ * @param {?proto.JobMetadata|undefined} value
+ * @return {!proto.JobSummary} returns this
+*/
+proto.JobSummary.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.JobSummary} returns this
+ */
+proto.JobSummary.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.JobSummary.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.ListJobsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.ListJobsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.ListJobsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.ListJobsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ListJobsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    jobsList: jspb.Message.toObjectList(msg.getJobsList(),
+    proto.JobSummary.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.ListJobsResponse}
+ */
+proto.ListJobsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.ListJobsResponse;
+  return proto.ListJobsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.ListJobsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.ListJobsResponse}
+ */
+proto.ListJobsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.JobSummary;
+      reader.readMessage(value,proto.JobSummary.deserializeBinaryFromReader);
+      msg.addJobs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.ListJobsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.ListJobsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.ListJobsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.ListJobsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getJobsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.JobSummary.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated JobSummary jobs = 1;
+ * @return {!Array<!proto.JobSummary>}
+ */
+proto.ListJobsResponse.prototype.getJobsList = function() {
+  return /** @type{!Array<!proto.JobSummary>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.JobSummary, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.JobSummary>} value
+ * @return {!proto.ListJobsResponse} returns this
+*/
+proto.ListJobsResponse.prototype.setJobsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.JobSummary=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.JobSummary}
+ */
+proto.ListJobsResponse.prototype.addJobs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.JobSummary, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.ListJobsResponse} returns this
+ */
+proto.ListJobsResponse.prototype.clearJobsList = function() {
+  return this.setJobsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.JobProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.JobProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.JobProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    phase: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    testsDone: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    testsTotal: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    stepsDone: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    stepsTotal: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    phaseElapsedMsMap: (f = msg.getPhaseElapsedMsMap()) ? f.toObject(includeInstance, undefined) : [],
+    etaMs: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.JobProgress}
+ */
+proto.JobProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.JobProgress;
+  return proto.JobProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.JobProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.JobProgress}
+ */
+proto.JobProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.JobProgress.Phase} */ (reader.readEnum());
+      msg.setPhase(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTestsDone(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTestsTotal(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStepsDone(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStepsTotal(value);
+      break;
+    case 6:
+      var value = msg.getPhaseElapsedMsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readInt64, null, "", 0);
+         });
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEtaMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.JobProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.JobProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.JobProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPhase();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getTestsDone();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getTestsTotal();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getStepsDone();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getStepsTotal();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getPhaseElapsedMsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeInt64);
+  }
+  f = message.getEtaMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.JobProgress.Phase = {
+  INITIALIZING: 0,
+  COLLECTING_COVERAGE: 1,
+  SORTING: 2,
+  BUILDING_STEP: 3,
+  PRUNING_DEAD_CODE: 4,
+  RENDERING: 5
+};
+
+/**
+ * optional Phase phase = 1;
+ * @return {!proto.JobProgress.Phase}
+ */
+proto.JobProgress.prototype.getPhase = function() {
+  return /** @type {!proto.JobProgress.Phase} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.JobProgress.Phase} value
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.setPhase = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional int32 tests_done = 2;
+ * @return {number}
+ */
+proto.JobProgress.prototype.getTestsDone = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.setTestsDone = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 tests_total = 3;
+ * @return {number}
+ */
+proto.JobProgress.prototype.getTestsTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.setTestsTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 steps_done = 4;
+ * @return {number}
+ */
+proto.JobProgress.prototype.getStepsDone = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.setStepsDone = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 steps_total = 5;
+ * @return {number}
+ */
+proto.JobProgress.prototype.getStepsTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.setStepsTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * map<string, int64> phase_elapsed_ms = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.JobProgress.prototype.getPhaseElapsedMsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.clearPhaseElapsedMsMap = function() {
+  this.getPhaseElapsedMsMap().clear();
+  return this;};
+
+
+/**
+ * optional int64 eta_ms = 7;
+ * @return {number}
+ */
+proto.JobProgress.prototype.getEtaMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.JobProgress} returns this
+ */
+proto.JobProgress.prototype.setEtaMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.JobResults.repeatedFields_ = [1,2,3,4,5,6,7,8,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.JobResults.prototype.toObject = function(opt_includeInstance) {
+  return proto.JobResults.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.JobResults} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobResults.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    testsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    filesList: jspb.Message.toObjectList(msg.getFilesList(),
+    proto.FileMap.toObject, includeInstance),
+    diffsList: jspb.Message.toObjectList(msg.getDiffsList(),
+    proto.StepDiff.toObject, includeInstance),
+    kindsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    testResultsList: jspb.Message.toObjectList(msg.getTestResultsList(),
+    proto.TestResult.toObject, includeInstance),
+    redundantTestsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    refinementsList: jspb.Message.toObjectList(msg.getRefinementsList(),
+    proto.TestRefinement.toObject, includeInstance),
+    chaptersList: jspb.Message.toObjectList(msg.getChaptersList(),
+    proto.Chapter.toObject, includeInstance),
+    metricsList: jspb.Message.toObjectList(msg.getMetricsList(),
+    proto.TestMetrics.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.JobResults}
+ */
+proto.JobResults.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.JobResults;
+  return proto.JobResults.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.JobResults} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.JobResults}
+ */
+proto.JobResults.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTests(value);
+      break;
+    case 2:
+      var value = new proto.FileMap;
+      reader.readMessage(value,proto.FileMap.deserializeBinaryFromReader);
+      msg.addFiles(value);
+      break;
+    case 3:
+      var value = new proto.StepDiff;
+      reader.readMessage(value,proto.StepDiff.deserializeBinaryFromReader);
+      msg.addDiffs(value);
+      break;
+    case 4:
+      var values = /** @type {!Array<!proto.TestKind>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addKinds(values[i]);
+      }
+      break;
+    case 5:
+      var value = new proto.TestResult;
+      reader.readMessage(value,proto.TestResult.deserializeBinaryFromReader);
+      msg.addTestResults(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRedundantTests(value);
+      break;
+    case 7:
+      var value = new proto.TestRefinement;
+      reader.readMessage(value,proto.TestRefinement.deserializeBinaryFromReader);
+      msg.addRefinements(value);
+      break;
+    case 8:
+      var value = new proto.Chapter;
+      reader.readMessage(value,proto.Chapter.deserializeBinaryFromReader);
+      msg.addChapters(value);
+      break;
+    case 9:
+      var value = new proto.TestMetrics;
+      reader.readMessage(value,proto.TestMetrics.deserializeBinaryFromReader);
+      msg.addMetrics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.JobResults.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.JobResults.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.JobResults} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.JobResults.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getFilesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.FileMap.serializeBinaryToWriter
+    );
+  }
+  f = message.getDiffsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.StepDiff.serializeBinaryToWriter
+    );
+  }
+  f = message.getKindsList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      4,
+      f
+    );
+  }
+  f = message.getTestResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.TestResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRedundantTestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getRefinementsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.TestRefinement.serializeBinaryToWriter
+    );
+  }
+  f = message.getChaptersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.Chapter.serializeBinaryToWriter
+    );
+  }
+  f = message.getMetricsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.TestMetrics.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated string tests = 1;
+ * @return {!Array<string>}
+ */
+proto.JobResults.prototype.getTestsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.setTestsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.addTests = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearTestsList = function() {
+  return this.setTestsList([]);
+};
+
+
+/**
+ * repeated FileMap files = 2;
+ * @return {!Array<!proto.FileMap>}
+ */
+proto.JobResults.prototype.getFilesList = function() {
+  return /** @type{!Array<!proto.FileMap>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.FileMap, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.FileMap>} value
+ * @return {!proto.JobResults} returns this
+*/
+proto.JobResults.prototype.setFilesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.FileMap=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.FileMap}
+ */
+proto.JobResults.prototype.addFiles = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.FileMap, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearFilesList = function() {
+  return this.setFilesList([]);
+};
+
+
+/**
+ * repeated StepDiff diffs = 3;
+ * @return {!Array<!proto.StepDiff>}
+ */
+proto.JobResults.prototype.getDiffsList = function() {
+  return /** @type{!Array<!proto.StepDiff>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.StepDiff, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.StepDiff>} value
+ * @return {!proto.JobResults} returns this
+*/
+proto.JobResults.prototype.setDiffsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.StepDiff=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.StepDiff}
+ */
+proto.JobResults.prototype.addDiffs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.StepDiff, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearDiffsList = function() {
+  return this.setDiffsList([]);
+};
+
+
+/**
+ * repeated TestKind kinds = 4;
+ * @return {!Array<!proto.TestKind>}
+ */
+proto.JobResults.prototype.getKindsList = function() {
+  return /** @type {!Array<!proto.TestKind>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.TestKind>} value
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.setKindsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {!proto.TestKind} value
+ * @param {number=} opt_index
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.addKinds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearKindsList = function() {
+  return this.setKindsList([]);
+};
+
+
+/**
+ * repeated TestResult test_results = 5;
+ * @return {!Array<!proto.TestResult>}
+ */
+proto.JobResults.prototype.getTestResultsList = function() {
+  return /** @type{!Array<!proto.TestResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.TestResult, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.TestResult>} value
+ * @return {!proto.JobResults} returns this
+*/
+proto.JobResults.prototype.setTestResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.TestResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.TestResult}
+ */
+proto.JobResults.prototype.addTestResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.TestResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearTestResultsList = function() {
+  return this.setTestResultsList([]);
+};
+
+
+/**
+ * repeated string redundant_tests = 6;
+ * @return {!Array<string>}
+ */
+proto.JobResults.prototype.getRedundantTestsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.setRedundantTestsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.addRedundantTests = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearRedundantTestsList = function() {
+  return this.setRedundantTestsList([]);
+};
+
+
+/**
+ * repeated TestRefinement refinements = 7;
+ * @return {!Array<!proto.TestRefinement>}
+ */
+proto.JobResults.prototype.getRefinementsList = function() {
+  return /** @type{!Array<!proto.TestRefinement>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.TestRefinement, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.TestRefinement>} value
+ * @return {!proto.JobResults} returns this
+*/
+proto.JobResults.prototype.setRefinementsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.TestRefinement=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.TestRefinement}
+ */
+proto.JobResults.prototype.addRefinements = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.TestRefinement, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearRefinementsList = function() {
+  return this.setRefinementsList([]);
+};
+
+
+/**
+ * repeated Chapter chapters = 8;
+ * @return {!Array<!proto.Chapter>}
+ */
+proto.JobResults.prototype.getChaptersList = function() {
+  return /** @type{!Array<!proto.Chapter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.Chapter, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.Chapter>} value
+ * @return {!proto.JobResults} returns this
+*/
+proto.JobResults.prototype.setChaptersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.Chapter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.Chapter}
+ */
+proto.JobResults.prototype.addChapters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.Chapter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearChaptersList = function() {
+  return this.setChaptersList([]);
+};
+
+
+/**
+ * repeated TestMetrics metrics = 9;
+ * @return {!Array<!proto.TestMetrics>}
+ */
+proto.JobResults.prototype.getMetricsList = function() {
+  return /** @type{!Array<!proto.TestMetrics>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.TestMetrics, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.TestMetrics>} value
+ * @return {!proto.JobResults} returns this
+*/
+proto.JobResults.prototype.setMetricsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.TestMetrics=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.TestMetrics}
+ */
+proto.JobResults.prototype.addMetrics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.TestMetrics, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.JobResults} returns this
+ */
+proto.JobResults.prototype.clearMetricsList = function() {
+  return this.setMetricsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.TestMetrics.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestMetrics.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.TestMetrics} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestMetrics.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    test: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    linesCovered: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    newLines: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    overlap: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    importance: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.TestMetrics}
+ */
+proto.TestMetrics.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.TestMetrics;
+  return proto.TestMetrics.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.TestMetrics} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.TestMetrics}
+ */
+proto.TestMetrics.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTest(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLinesCovered(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setNewLines(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setOverlap(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setImportance(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.TestMetrics.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.TestMetrics.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.TestMetrics} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestMetrics.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTest();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLinesCovered();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getNewLines();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getOverlap();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getImportance();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string test = 1;
+ * @return {string}
+ */
+proto.TestMetrics.prototype.getTest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestMetrics} returns this
+ */
+proto.TestMetrics.prototype.setTest = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 lines_covered = 2;
+ * @return {number}
+ */
+proto.TestMetrics.prototype.getLinesCovered = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestMetrics} returns this
+ */
+proto.TestMetrics.prototype.setLinesCovered = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 new_lines = 3;
+ * @return {number}
+ */
+proto.TestMetrics.prototype.getNewLines = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestMetrics} returns this
+ */
+proto.TestMetrics.prototype.setNewLines = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 overlap = 4;
+ * @return {number}
  */
-proto.JobStatusResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.TestMetrics.prototype.getOverlap = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
-goog.inherits(proto.JobStatusResponse, jspb.Message);
-if (goog.DEBUG && !COMPILED) {
-  /**
-   * @public
-   * @override
-   */
-  proto.JobStatusResponse.displayName = 'proto.JobStatusResponse';
-}
+
+
 /**
- * Generated by JsPbCodeGenerator.
- * @param {Array=} opt_data Optional initial data array, typically from a
- * server response, or constructed directly in Javascript. The array is used
- * in place and becomes part of the constructed object. It is not cloned.
- * If no data is provided, the constructed object will be empty, but still
- * valid.
- * @extends {jspb.Message}
- * @constructor
+ * @param {number} value
+ * @return {!proto.TestMetrics} returns this
  */
-proto.JobResults = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.JobResults.repeatedFields_, null);
+proto.TestMetrics.prototype.setOverlap = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
-goog.inherits(proto.JobResults, jspb.Message);
-if (goog.DEBUG && !COMPILED) {
-  /**
-   * @public
-   * @override
-   */
-  proto.JobResults.displayName = 'proto.JobResults';
-}
+
+
 /**
- * Generated by JsPbCodeGenerator.
- * @param {Array=} opt_data Optional initial data array, typically from a
- * server response, or constructed directly in Javascript. The array is used
- * in place and becomes part of the constructed object. It is not cloned.
- * If no data is provided, the constructed object will be empty, but still
- * valid.
- * @extends {jspb.Message}
- * @constructor
+ * optional double importance = 5;
+ * @return {number}
  */
-proto.FileMap = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.TestMetrics.prototype.getImportance = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
 };
-goog.inherits(proto.FileMap, jspb.Message);
-if (goog.DEBUG && !COMPILED) {
-  /**
-   * @public
-   * @override
-   */
-  proto.FileMap.displayName = 'proto.FileMap';
-}
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestMetrics} returns this
+ */
+proto.TestMetrics.prototype.setImportance = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.StartJobRequest.repeatedFields_ = [1];
+proto.Chapter.repeatedFields_ = [2];
 
 
 
@@ -171,8 +4823,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.StartJobRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.StartJobRequest.toObject(opt_includeInstance, this);
+proto.Chapter.prototype.toObject = function(opt_includeInstance) {
+  return proto.Chapter.toObject(opt_includeInstance, this);
 };
 
 
@@ -181,15 +4833,14 @@ proto.StartJobRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.StartJobRequest} msg The msg instance to transform.
+ * @param {!proto.Chapter} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.StartJobRequest.toObject = function(includeInstance, msg) {
+proto.Chapter.toObject = function(includeInstance, msg) {
   var f, obj = {
-    testsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    pkg: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    sort: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    title: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    testsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -203,23 +4854,23 @@ proto.StartJobRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.StartJobRequest}
+ * @return {!proto.Chapter}
  */
-proto.StartJobRequest.deserializeBinary = function(bytes) {
+proto.Chapter.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.StartJobRequest;
-  return proto.StartJobRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.Chapter;
+  return proto.Chapter.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.StartJobRequest} msg The message object to deserialize into.
+ * @param {!proto.Chapter} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.StartJobRequest}
+ * @return {!proto.Chapter}
  */
-proto.StartJobRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.Chapter.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -228,15 +4879,11 @@ proto.StartJobRequest.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addTests(value);
+      msg.setTitle(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPkg(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.StartJobRequest.SortType} */ (reader.readEnum());
-      msg.setSort(value);
+      msg.addTests(value);
       break;
     default:
       reader.skipField();
@@ -251,9 +4898,9 @@ proto.StartJobRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.StartJobRequest.prototype.serializeBinary = function() {
+proto.Chapter.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.StartJobRequest.serializeBinaryToWriter(this, writer);
+  proto.Chapter.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -261,120 +4908,278 @@ proto.StartJobRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.StartJobRequest} message
+ * @param {!proto.Chapter} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.StartJobRequest.serializeBinaryToWriter = function(message, writer) {
+proto.Chapter.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTestsList();
+  f = message.getTitle();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getPkg();
+  f = message.getTestsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getSort();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * @enum {number}
+ * optional string title = 1;
+ * @return {string}
  */
-proto.StartJobRequest.SortType = {
-  HARDCODED: 0,
-  RAW: 1,
-  NET: 2,
-  IMPORTANCE: 3
+proto.Chapter.prototype.getTitle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.Chapter} returns this
+ */
+proto.Chapter.prototype.setTitle = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
+
 /**
- * repeated string tests = 1;
+ * repeated string tests = 2;
  * @return {!Array<string>}
  */
-proto.StartJobRequest.prototype.getTestsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.Chapter.prototype.getTestsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.StartJobRequest} returns this
+ * @return {!proto.Chapter} returns this
  */
-proto.StartJobRequest.prototype.setTestsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.Chapter.prototype.setTestsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.StartJobRequest} returns this
+ * @return {!proto.Chapter} returns this
  */
-proto.StartJobRequest.prototype.addTests = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.Chapter.prototype.addTests = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.StartJobRequest} returns this
+ * @return {!proto.Chapter} returns this
  */
-proto.StartJobRequest.prototype.clearTestsList = function() {
+proto.Chapter.prototype.clearTestsList = function() {
   return this.setTestsList([]);
 };
 
 
+
 /**
- * optional string pkg = 2;
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.TestRefinement.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.TestRefinement.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestRefinement.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.TestRefinement} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestRefinement.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    test: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    refinesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.TestRefinement}
+ */
+proto.TestRefinement.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.TestRefinement;
+  return proto.TestRefinement.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.TestRefinement} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.TestRefinement}
+ */
+proto.TestRefinement.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTest(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRefines(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.TestRefinement.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.TestRefinement.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.TestRefinement} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.TestRefinement.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTest();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRefinesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string test = 1;
  * @return {string}
  */
-proto.StartJobRequest.prototype.getPkg = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.TestRefinement.prototype.getTest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestRefinement} returns this
+ */
+proto.TestRefinement.prototype.setTest = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string refines = 2;
+ * @return {!Array<string>}
+ */
+proto.TestRefinement.prototype.getRefinesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.StartJobRequest} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.TestRefinement} returns this
  */
-proto.StartJobRequest.prototype.setPkg = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.TestRefinement.prototype.setRefinesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * optional SortType sort = 3;
- * @return {!proto.StartJobRequest.SortType}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.TestRefinement} returns this
  */
-proto.StartJobRequest.prototype.getSort = function() {
-  return /** @type {!proto.StartJobRequest.SortType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.TestRefinement.prototype.addRefines = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * @param {!proto.StartJobRequest.SortType} value
- * @return {!proto.StartJobRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestRefinement} returns this
  */
-proto.StartJobRequest.prototype.setSort = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.TestRefinement.prototype.clearRefinesList = function() {
+  return this.setRefinesList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.TestResult.repeatedFields_ = [5];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -390,8 +5195,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.StartJobResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.StartJobResponse.toObject(opt_includeInstance, this);
+proto.TestResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.TestResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -400,13 +5205,17 @@ proto.StartJobResponse.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.StartJobResponse} msg The msg instance to transform.
+ * @param {!proto.TestResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.StartJobResponse.toObject = function(includeInstance, msg) {
+proto.TestResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    test: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    outcome: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    output: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    subtestsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -420,23 +5229,23 @@ proto.StartJobResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.StartJobResponse}
+ * @return {!proto.TestResult}
  */
-proto.StartJobResponse.deserializeBinary = function(bytes) {
+proto.TestResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.StartJobResponse;
-  return proto.StartJobResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.TestResult;
+  return proto.TestResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.StartJobResponse} msg The message object to deserialize into.
+ * @param {!proto.TestResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.StartJobResponse}
+ * @return {!proto.TestResult}
  */
-proto.StartJobResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.TestResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -445,7 +5254,23 @@ proto.StartJobResponse.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setTest(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.TestOutcome} */ (reader.readEnum());
+      msg.setOutcome(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOutput(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDurationMs(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSubtests(value);
       break;
     default:
       reader.skipField();
@@ -460,9 +5285,9 @@ proto.StartJobResponse.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.StartJobResponse.prototype.serializeBinary = function() {
+proto.TestResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.StartJobResponse.serializeBinaryToWriter(this, writer);
+  proto.TestResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -470,40 +5295,166 @@ proto.StartJobResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.StartJobResponse} message
+ * @param {!proto.TestResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.StartJobResponse.serializeBinaryToWriter = function(message, writer) {
+proto.TestResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getTest();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getOutcome();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getOutput();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDurationMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getSubtestsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string test = 1;
  * @return {string}
  */
-proto.StartJobResponse.prototype.getId = function() {
+proto.TestResult.prototype.getTest = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.StartJobResponse} returns this
+ * @return {!proto.TestResult} returns this
  */
-proto.StartJobResponse.prototype.setId = function(value) {
+proto.TestResult.prototype.setTest = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional TestOutcome outcome = 2;
+ * @return {!proto.TestOutcome}
+ */
+proto.TestResult.prototype.getOutcome = function() {
+  return /** @type {!proto.TestOutcome} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.TestOutcome} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setOutcome = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string output = 3;
+ * @return {string}
+ */
+proto.TestResult.prototype.getOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setOutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int64 duration_ms = 4;
+ * @return {number}
+ */
+proto.TestResult.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * repeated string subtests = 5;
+ * @return {!Array<string>}
+ */
+proto.TestResult.prototype.getSubtestsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.setSubtestsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.addSubtests = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.TestResult} returns this
+ */
+proto.TestResult.prototype.clearSubtestsList = function() {
+  return this.setSubtestsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.StepDiff.repeatedFields_ = [1];
 
 
 
@@ -520,8 +5471,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.CheckoutFilesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.CheckoutFilesRequest.toObject(opt_includeInstance, this);
+proto.StepDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.StepDiff.toObject(opt_includeInstance, this);
 };
 
 
@@ -530,13 +5481,14 @@ proto.CheckoutFilesRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.CheckoutFilesRequest} msg The msg instance to transform.
+ * @param {!proto.StepDiff} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CheckoutFilesRequest.toObject = function(includeInstance, msg) {
+proto.StepDiff.toObject = function(includeInstance, msg) {
   var f, obj = {
-    files: (f = msg.getFiles()) && proto.FileMap.toObject(includeInstance, f)
+    filesList: jspb.Message.toObjectList(msg.getFilesList(),
+    proto.FileDiff.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -550,23 +5502,23 @@ proto.CheckoutFilesRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.CheckoutFilesRequest}
+ * @return {!proto.StepDiff}
  */
-proto.CheckoutFilesRequest.deserializeBinary = function(bytes) {
+proto.StepDiff.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.CheckoutFilesRequest;
-  return proto.CheckoutFilesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.StepDiff;
+  return proto.StepDiff.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.CheckoutFilesRequest} msg The message object to deserialize into.
+ * @param {!proto.StepDiff} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.CheckoutFilesRequest}
+ * @return {!proto.StepDiff}
  */
-proto.CheckoutFilesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.StepDiff.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -574,9 +5526,9 @@ proto.CheckoutFilesRequest.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.FileMap;
-      reader.readMessage(value,proto.FileMap.deserializeBinaryFromReader);
-      msg.setFiles(value);
+      var value = new proto.FileDiff;
+      reader.readMessage(value,proto.FileDiff.deserializeBinaryFromReader);
+      msg.addFiles(value);
       break;
     default:
       reader.skipField();
@@ -591,9 +5543,9 @@ proto.CheckoutFilesRequest.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.CheckoutFilesRequest.prototype.serializeBinary = function() {
+proto.StepDiff.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.CheckoutFilesRequest.serializeBinaryToWriter(this, writer);
+  proto.StepDiff.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -601,57 +5553,58 @@ proto.CheckoutFilesRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.CheckoutFilesRequest} message
+ * @param {!proto.StepDiff} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.CheckoutFilesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.StepDiff.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFiles();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getFilesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
       f,
-      proto.FileMap.serializeBinaryToWriter
+      proto.FileDiff.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional FileMap files = 1;
- * @return {?proto.FileMap}
+ * repeated FileDiff files = 1;
+ * @return {!Array<!proto.FileDiff>}
  */
-proto.CheckoutFilesRequest.prototype.getFiles = function() {
-  return /** @type{?proto.FileMap} */ (
-    jspb.Message.getWrapperField(this, proto.FileMap, 1));
+proto.StepDiff.prototype.getFilesList = function() {
+  return /** @type{!Array<!proto.FileDiff>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.FileDiff, 1));
 };
 
 
 /**
- * @param {?proto.FileMap|undefined} value
- * @return {!proto.CheckoutFilesRequest} returns this
+ * @param {!Array<!proto.FileDiff>} value
+ * @return {!proto.StepDiff} returns this
 */
-proto.CheckoutFilesRequest.prototype.setFiles = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.StepDiff.prototype.setFilesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.CheckoutFilesRequest} returns this
+ * @param {!proto.FileDiff=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.FileDiff}
  */
-proto.CheckoutFilesRequest.prototype.clearFiles = function() {
-  return this.setFiles(undefined);
+proto.StepDiff.prototype.addFiles = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.FileDiff, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.StepDiff} returns this
  */
-proto.CheckoutFilesRequest.prototype.hasFiles = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.StepDiff.prototype.clearFilesList = function() {
+  return this.setFilesList([]);
 };
 
 
@@ -671,8 +5624,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.JobStatusResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.JobStatusResponse.toObject(opt_includeInstance, this);
+proto.FileDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.FileDiff.toObject(opt_includeInstance, this);
 };
 
 
@@ -681,16 +5634,16 @@ proto.JobStatusResponse.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.JobStatusResponse} msg The msg instance to transform.
+ * @param {!proto.FileDiff} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.JobStatusResponse.toObject = function(includeInstance, msg) {
+proto.FileDiff.toObject = function(includeInstance, msg) {
   var f, obj = {
-    complete: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    details: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    error: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    results: (f = msg.getResults()) && proto.JobResults.toObject(includeInstance, f)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    added: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    removed: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    unified: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -704,23 +5657,23 @@ proto.JobStatusResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.JobStatusResponse}
+ * @return {!proto.FileDiff}
  */
-proto.JobStatusResponse.deserializeBinary = function(bytes) {
+proto.FileDiff.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.JobStatusResponse;
-  return proto.JobStatusResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.FileDiff;
+  return proto.FileDiff.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.JobStatusResponse} msg The message object to deserialize into.
+ * @param {!proto.FileDiff} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.JobStatusResponse}
+ * @return {!proto.FileDiff}
  */
-proto.JobStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.FileDiff.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -728,21 +5681,20 @@ proto.JobStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setComplete(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDetails(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setAdded(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setError(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRemoved(value);
       break;
     case 4:
-      var value = new proto.JobResults;
-      reader.readMessage(value,proto.JobResults.deserializeBinaryFromReader);
-      msg.setResults(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnified(value);
       break;
     default:
       reader.skipField();
@@ -757,9 +5709,9 @@ proto.JobStatusResponse.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.JobStatusResponse.prototype.serializeBinary = function() {
+proto.FileDiff.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.JobStatusResponse.serializeBinaryToWriter(this, writer);
+  proto.FileDiff.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -767,143 +5719,116 @@ proto.JobStatusResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.JobStatusResponse} message
+ * @param {!proto.FileDiff} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.JobStatusResponse.serializeBinaryToWriter = function(message, writer) {
+proto.FileDiff.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getComplete();
-  if (f) {
-    writer.writeBool(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getDetails();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getAdded();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
-  f = message.getError();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRemoved();
+  if (f !== 0) {
+    writer.writeInt32(
       3,
-      f
-    );
-  }
-  f = message.getResults();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.JobResults.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional bool complete = 1;
- * @return {boolean}
- */
-proto.JobStatusResponse.prototype.getComplete = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.JobStatusResponse} returns this
- */
-proto.JobStatusResponse.prototype.setComplete = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+      f
+    );
+  }
+  f = message.getUnified();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string details = 2;
+ * optional string name = 1;
  * @return {string}
  */
-proto.JobStatusResponse.prototype.getDetails = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.FileDiff.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.JobStatusResponse} returns this
+ * @return {!proto.FileDiff} returns this
  */
-proto.JobStatusResponse.prototype.setDetails = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.FileDiff.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string error = 3;
- * @return {string}
+ * optional int32 added = 2;
+ * @return {number}
  */
-proto.JobStatusResponse.prototype.getError = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.FileDiff.prototype.getAdded = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.JobStatusResponse} returns this
+ * @param {number} value
+ * @return {!proto.FileDiff} returns this
  */
-proto.JobStatusResponse.prototype.setError = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.FileDiff.prototype.setAdded = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional JobResults results = 4;
- * @return {?proto.JobResults}
+ * optional int32 removed = 3;
+ * @return {number}
  */
-proto.JobStatusResponse.prototype.getResults = function() {
-  return /** @type{?proto.JobResults} */ (
-    jspb.Message.getWrapperField(this, proto.JobResults, 4));
+proto.FileDiff.prototype.getRemoved = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {?proto.JobResults|undefined} value
- * @return {!proto.JobStatusResponse} returns this
-*/
-proto.JobStatusResponse.prototype.setResults = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * @param {number} value
+ * @return {!proto.FileDiff} returns this
+ */
+proto.FileDiff.prototype.setRemoved = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.JobStatusResponse} returns this
+ * optional string unified = 4;
+ * @return {string}
  */
-proto.JobStatusResponse.prototype.clearResults = function() {
-  return this.setResults(undefined);
+proto.FileDiff.prototype.getUnified = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.FileDiff} returns this
  */
-proto.JobStatusResponse.prototype.hasResults = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.FileDiff.prototype.setUnified = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.JobResults.repeatedFields_ = [1,2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -919,8 +5844,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.JobResults.prototype.toObject = function(opt_includeInstance) {
-  return proto.JobResults.toObject(opt_includeInstance, this);
+proto.JobEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.JobEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -929,15 +5854,20 @@ proto.JobResults.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.JobResults} msg The msg instance to transform.
+ * @param {!proto.JobEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.JobResults.toObject = function(includeInstance, msg) {
+proto.JobEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    testsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    filesList: jspb.Message.toObjectList(msg.getFilesList(),
-    proto.FileMap.toObject, includeInstance)
+    type: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    step: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    test: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    diff: (f = msg.getDiff()) && proto.StepDiff.toObject(includeInstance, f),
+    status: (f = msg.getStatus()) && proto.JobStatusResponse.toObject(includeInstance, f),
+    progress: (f = msg.getProgress()) && proto.JobProgress.toObject(includeInstance, f),
+    kind: jspb.Message.getFieldWithDefault(msg, 8, 0)
   };
 
   if (includeInstance) {
@@ -951,23 +5881,23 @@ proto.JobResults.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.JobResults}
+ * @return {!proto.JobEvent}
  */
-proto.JobResults.deserializeBinary = function(bytes) {
+proto.JobEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.JobResults;
-  return proto.JobResults.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.JobEvent;
+  return proto.JobEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.JobResults} msg The message object to deserialize into.
+ * @param {!proto.JobEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.JobResults}
+ * @return {!proto.JobEvent}
  */
-proto.JobResults.deserializeBinaryFromReader = function(msg, reader) {
+proto.JobEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -975,13 +5905,39 @@ proto.JobResults.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addTests(value);
+      var value = /** @type {!proto.JobEvent.Type} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 2:
-      var value = new proto.FileMap;
-      reader.readMessage(value,proto.FileMap.deserializeBinaryFromReader);
-      msg.addFiles(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStep(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTest(value);
+      break;
+    case 5:
+      var value = new proto.StepDiff;
+      reader.readMessage(value,proto.StepDiff.deserializeBinaryFromReader);
+      msg.setDiff(value);
+      break;
+    case 6:
+      var value = new proto.JobStatusResponse;
+      reader.readMessage(value,proto.JobStatusResponse.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 7:
+      var value = new proto.JobProgress;
+      reader.readMessage(value,proto.JobProgress.deserializeBinaryFromReader);
+      msg.setProgress(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.TestKind} */ (reader.readEnum());
+      msg.setKind(value);
       break;
     default:
       reader.skipField();
@@ -996,9 +5952,9 @@ proto.JobResults.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.JobResults.prototype.serializeBinary = function() {
+proto.JobEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.JobResults.serializeBinaryToWriter(this, writer);
+  proto.JobEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1006,102 +5962,281 @@ proto.JobResults.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.JobResults} message
+ * @param {!proto.JobEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.JobResults.serializeBinaryToWriter = function(message, writer) {
+proto.JobEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTestsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getFilesList();
+  f = message.getMessage();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
+      f
+    );
+  }
+  f = message.getStep();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getTest();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f != null) {
+    writer.writeMessage(
+      5,
       f,
-      proto.FileMap.serializeBinaryToWriter
+      proto.StepDiff.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.JobStatusResponse.serializeBinaryToWriter
+    );
+  }
+  f = message.getProgress();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.JobProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
     );
   }
 };
 
 
 /**
- * repeated string tests = 1;
- * @return {!Array<string>}
+ * @enum {number}
  */
-proto.JobResults.prototype.getTestsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.JobEvent.Type = {
+  STATUS: 0,
+  STEP: 1,
+  DONE: 2
+};
+
+/**
+ * optional Type type = 1;
+ * @return {!proto.JobEvent.Type}
+ */
+proto.JobEvent.prototype.getType = function() {
+  return /** @type {!proto.JobEvent.Type} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.JobResults} returns this
+ * @param {!proto.JobEvent.Type} value
+ * @return {!proto.JobEvent} returns this
  */
-proto.JobResults.prototype.setTestsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.JobEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.JobEvent.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.JobResults} returns this
+ * @return {!proto.JobEvent} returns this
  */
-proto.JobResults.prototype.addTests = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.JobEvent.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.JobResults} returns this
+ * optional int32 step = 3;
+ * @return {number}
  */
-proto.JobResults.prototype.clearTestsList = function() {
-  return this.setTestsList([]);
+proto.JobEvent.prototype.getStep = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * repeated FileMap files = 2;
- * @return {!Array<!proto.FileMap>}
+ * @param {number} value
+ * @return {!proto.JobEvent} returns this
  */
-proto.JobResults.prototype.getFilesList = function() {
-  return /** @type{!Array<!proto.FileMap>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.FileMap, 2));
+proto.JobEvent.prototype.setStep = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!Array<!proto.FileMap>} value
- * @return {!proto.JobResults} returns this
+ * optional string test = 4;
+ * @return {string}
+ */
+proto.JobEvent.prototype.getTest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.JobEvent} returns this
+ */
+proto.JobEvent.prototype.setTest = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional StepDiff diff = 5;
+ * @return {?proto.StepDiff}
+ */
+proto.JobEvent.prototype.getDiff = function() {
+  return /** @type{?proto.StepDiff} */ (
+    jspb.Message.getWrapperField(this, proto.StepDiff, 5));
+};
+
+
+/**
+ * @param {?proto.StepDiff|undefined} value
+ * @return {!proto.JobEvent} returns this
 */
-proto.JobResults.prototype.setFilesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.JobEvent.prototype.setDiff = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.FileMap=} opt_value
- * @param {number=} opt_index
- * @return {!proto.FileMap}
+ * Clears the message field making it undefined.
+ * @return {!proto.JobEvent} returns this
  */
-proto.JobResults.prototype.addFiles = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.FileMap, opt_index);
+proto.JobEvent.prototype.clearDiff = function() {
+  return this.setDiff(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.JobResults} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.JobResults.prototype.clearFilesList = function() {
-  return this.setFilesList([]);
+proto.JobEvent.prototype.hasDiff = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional JobStatusResponse status = 6;
+ * @return {?proto.JobStatusResponse}
+ */
+proto.JobEvent.prototype.getStatus = function() {
+  return /** @type{?proto.JobStatusResponse} */ (
+    jspb.Message.getWrapperField(this, proto.JobStatusResponse, 6));
+};
+
+
+/**
+ * @param {?proto.JobStatusResponse|undefined} value
+ * @return {!proto.JobEvent} returns this
+*/
+proto.JobEvent.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.JobEvent} returns this
+ */
+proto.JobEvent.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.JobEvent.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional JobProgress progress = 7;
+ * @return {?proto.JobProgress}
+ */
+proto.JobEvent.prototype.getProgress = function() {
+  return /** @type{?proto.JobProgress} */ (
+    jspb.Message.getWrapperField(this, proto.JobProgress, 7));
+};
+
+
+/**
+ * @param {?proto.JobProgress|undefined} value
+ * @return {!proto.JobEvent} returns this
+*/
+proto.JobEvent.prototype.setProgress = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.JobEvent} returns this
+ */
+proto.JobEvent.prototype.clearProgress = function() {
+  return this.setProgress(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.JobEvent.prototype.hasProgress = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional TestKind kind = 8;
+ * @return {!proto.TestKind}
+ */
+proto.JobEvent.prototype.getKind = function() {
+  return /** @type {!proto.TestKind} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.TestKind} value
+ * @return {!proto.JobEvent} returns this
+ */
+proto.JobEvent.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
 
@@ -1238,4 +6373,24 @@ proto.FileMap.prototype.clearFilesMap = function() {
   return this;};
 
 
+/**
+ * @enum {number}
+ */
+proto.TestOutcome = {
+  PASS: 0,
+  FAIL: 1,
+  SKIP: 2,
+  BUILD_ERROR: 3,
+  TIMEOUT: 4
+};
+
+/**
+ * @enum {number}
+ */
+proto.TestKind = {
+  TEST: 0,
+  EXAMPLE: 1,
+  FUZZ: 2
+};
+
 goog.object.extend(exports, proto);
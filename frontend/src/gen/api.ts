@@ -4,17 +4,150 @@ import _m0 from "protobufjs/minimal";
 
 export const protobufPackage = "";
 
+/** TestOutcome is how running a test to collect its coverage ended */
+export enum TestOutcome {
+  PASS = 0,
+  FAIL = 1,
+  SKIP = 2,
+  BUILD_ERROR = 3,
+  TIMEOUT = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function testOutcomeFromJSON(object: any): TestOutcome {
+  switch (object) {
+    case 0:
+    case "PASS":
+      return TestOutcome.PASS;
+    case 1:
+    case "FAIL":
+      return TestOutcome.FAIL;
+    case 2:
+    case "SKIP":
+      return TestOutcome.SKIP;
+    case 3:
+    case "BUILD_ERROR":
+      return TestOutcome.BUILD_ERROR;
+    case 4:
+    case "TIMEOUT":
+      return TestOutcome.TIMEOUT;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TestOutcome.UNRECOGNIZED;
+  }
+}
+
+export function testOutcomeToJSON(object: TestOutcome): string {
+  switch (object) {
+    case TestOutcome.PASS:
+      return "PASS";
+    case TestOutcome.FAIL:
+      return "FAIL";
+    case TestOutcome.SKIP:
+      return "SKIP";
+    case TestOutcome.BUILD_ERROR:
+      return "BUILD_ERROR";
+    case TestOutcome.TIMEOUT:
+      return "TIMEOUT";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/** TestKind is the kind of function a step of the log was built from */
+export enum TestKind {
+  TEST = 0,
+  EXAMPLE = 1,
+  FUZZ = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function testKindFromJSON(object: any): TestKind {
+  switch (object) {
+    case 0:
+    case "TEST":
+      return TestKind.TEST;
+    case 1:
+    case "EXAMPLE":
+      return TestKind.EXAMPLE;
+    case 2:
+    case "FUZZ":
+      return TestKind.FUZZ;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TestKind.UNRECOGNIZED;
+  }
+}
+
+export function testKindToJSON(object: TestKind): string {
+  switch (object) {
+    case TestKind.TEST:
+      return "TEST";
+    case TestKind.EXAMPLE:
+      return "EXAMPLE";
+    case TestKind.FUZZ:
+      return "FUZZ";
+    default:
+      return "UNKNOWN";
+  }
+}
+
 export interface StartJobRequest {
   tests: string[];
   pkg: string;
   sort: StartJobRequest_SortType;
+  options: TestOptions | undefined;
+  failurePolicy: StartJobRequest_FailurePolicy;
+  /**
+   * constraints the order of the log has to satisfy. The sort orders the
+   * tests they leave free. Ignored by HARDCODED sorts
+   */
+  constraints: SortConstraints | undefined;
 }
 
+/**
+ * SortType is how the tests of a log are ordered. How long tests take
+ * only breaks ties: of two tests a sort has no other preference between,
+ * the quicker comes first
+ */
 export enum StartJobRequest_SortType {
   HARDCODED = 0,
   RAW = 1,
   NET = 2,
   IMPORTANCE = 3,
+  /**
+   * DURATION - quickest tests first, by how long they took to run, then by the
+   * fewest lines covered
+   */
+  DURATION = 4,
+  /**
+   * FREQUENCY - hottest code first, by how many times the lines each test covers
+   * run across every test. Tests are run with -covermode=count unless
+   * the job sets a cover mode
+   */
+  FREQUENCY = 5,
+  /**
+   * MINIMAL - the fewest tests that together cover everything the whole suite
+   * covers, smallest first. The other tests are reported as redundant
+   */
+  MINIMAL = 6,
+  /**
+   * CALLGRAPH - functions before the functions calling them, by the static call graph
+   * of the covered source
+   */
+  CALLGRAPH = 7,
+  /**
+   * CONTAINMENT - every test after the tests whose coverage its coverage contains, which
+   * are reported in the refinements of the job's results
+   */
+  CONTAINMENT = 8,
+  /**
+   * CHAPTERS - tests covering similar code grouped into chapters, which are reported
+   * in the chapters of the job's results
+   */
+  CHAPTERS = 9,
   UNRECOGNIZED = -1,
 }
 
@@ -34,6 +167,24 @@ export function startJobRequest_SortTypeFromJSON(
     case 3:
     case "IMPORTANCE":
       return StartJobRequest_SortType.IMPORTANCE;
+    case 4:
+    case "DURATION":
+      return StartJobRequest_SortType.DURATION;
+    case 5:
+    case "FREQUENCY":
+      return StartJobRequest_SortType.FREQUENCY;
+    case 6:
+    case "MINIMAL":
+      return StartJobRequest_SortType.MINIMAL;
+    case 7:
+    case "CALLGRAPH":
+      return StartJobRequest_SortType.CALLGRAPH;
+    case 8:
+    case "CONTAINMENT":
+      return StartJobRequest_SortType.CONTAINMENT;
+    case 9:
+    case "CHAPTERS":
+      return StartJobRequest_SortType.CHAPTERS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -53,11 +204,146 @@ export function startJobRequest_SortTypeToJSON(
       return "NET";
     case StartJobRequest_SortType.IMPORTANCE:
       return "IMPORTANCE";
+    case StartJobRequest_SortType.DURATION:
+      return "DURATION";
+    case StartJobRequest_SortType.FREQUENCY:
+      return "FREQUENCY";
+    case StartJobRequest_SortType.MINIMAL:
+      return "MINIMAL";
+    case StartJobRequest_SortType.CALLGRAPH:
+      return "CALLGRAPH";
+    case StartJobRequest_SortType.CONTAINMENT:
+      return "CONTAINMENT";
+    case StartJobRequest_SortType.CHAPTERS:
+      return "CHAPTERS";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+/**
+ * FailurePolicy is what to do with tests that don't pass. Tests that fail
+ * to build always fail the job.
+ */
+export enum StartJobRequest_FailurePolicy {
+  /**
+   * FAIL_FAST - fail the job as soon as a test fails or times out. Skipped tests are
+   * kept in the log
+   */
+  FAIL_FAST = 0,
+  /** DROP_FAILING - leave failing, timed out and skipped tests out of the log */
+  DROP_FAILING = 1,
+  /**
+   * KEEP_PARTIAL - build the log with whatever coverage failing, timed out and skipped
+   * tests collected before they stopped
+   */
+  KEEP_PARTIAL = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function startJobRequest_FailurePolicyFromJSON(
+  object: any
+): StartJobRequest_FailurePolicy {
+  switch (object) {
+    case 0:
+    case "FAIL_FAST":
+      return StartJobRequest_FailurePolicy.FAIL_FAST;
+    case 1:
+    case "DROP_FAILING":
+      return StartJobRequest_FailurePolicy.DROP_FAILING;
+    case 2:
+    case "KEEP_PARTIAL":
+      return StartJobRequest_FailurePolicy.KEEP_PARTIAL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return StartJobRequest_FailurePolicy.UNRECOGNIZED;
+  }
+}
+
+export function startJobRequest_FailurePolicyToJSON(
+  object: StartJobRequest_FailurePolicy
+): string {
+  switch (object) {
+    case StartJobRequest_FailurePolicy.FAIL_FAST:
+      return "FAIL_FAST";
+    case StartJobRequest_FailurePolicy.DROP_FAILING:
+      return "DROP_FAILING";
+    case StartJobRequest_FailurePolicy.KEEP_PARTIAL:
+      return "KEEP_PARTIAL";
     default:
       return "UNKNOWN";
   }
 }
 
+/**
+ * SortConstraints fix parts of the order of a log. Constraints on tests that
+ * aren't in the log, because they were left out or aren't among the job's
+ * tests, are ignored
+ */
+export interface SortConstraints {
+  /** tests that start the log, in this order */
+  first: string[];
+  /** tests that end the log, in this order */
+  last: string[];
+  pins: SortConstraints_Pin[];
+  orders: SortConstraints_Order[];
+}
+
+/** Pin fixes the step of a test */
+export interface SortConstraints_Pin {
+  test: string;
+  /** the step of the log the test is, starting from 1 */
+  step: number;
+}
+
+/** Order requires a test to come before another */
+export interface SortConstraints_Order {
+  before: string;
+  after: string;
+}
+
+/** TestOptions configures how tests are run to collect coverage */
+export interface TestOptions {
+  /**
+   * packages to collect coverage for, as for go test -coverpkg. Defaults to
+   * the package under test
+   */
+  coverPkgs: string[];
+  /** build tags, as for go test -tags */
+  tags: string[];
+  /**
+   * environment variables to build and run the tests with, in addition to
+   * the server's own environment
+   */
+  env: { [key: string]: string };
+  /**
+   * time limit for each test, as a go duration like "30s", as for go test
+   * -timeout. Defaults to go test's default
+   */
+  timeout: string;
+  short: boolean;
+  /** number of times to run each test, as for go test -count. Defaults to 1 */
+  count: number;
+  /**
+   * extra arguments passed to go test after the others, when listing tests
+   * as well as running them. Since they can't be split between building
+   * and running a test binary, tests with args are each run with go test
+   * rather than a single binary built for the job, which is slower
+   */
+  args: string[];
+  /**
+   * coverage mode, as for go test -covermode: set, count or atomic. count
+   * and atomic record how many times each block ran. Defaults to set
+   */
+  coverMode: string;
+}
+
+export interface TestOptions_EnvEntry {
+  key: string;
+  value: string;
+}
+
 export interface StartJobResponse {
   id: string;
 }
@@ -66,16 +352,326 @@ export interface CheckoutFilesRequest {
   files: FileMap | undefined;
 }
 
+export interface ExportRepositoryRequest {
+  dir: string;
+}
+
+/**
+ * ListTestsRequest is posted to /listTests to list the tests of a package
+ * built with options, as a job with the same options would build them
+ */
+export interface ListTestsRequest {
+  pkg: string;
+  options: TestOptions | undefined;
+}
+
 export interface JobStatusResponse {
   complete: boolean;
   details: string;
   error: string;
   results: JobResults | undefined;
+  cancelled: boolean;
+  progress: JobProgress | undefined;
+  metadata: JobMetadata | undefined;
+}
+
+/** JobMetadata describes what a job was started with and when */
+export interface JobMetadata {
+  pkg: string;
+  sort: StartJobRequest_SortType;
+  tests: string[];
+  /** unix times in milliseconds. finished_at_ms is 0 until the job finishes */
+  createdAtMs: number;
+  finishedAtMs: number;
+  /** time taken to run the job so far in milliseconds */
+  durationMs: number;
+}
+
+/** JobSummary describes a job without its results, for listing jobs */
+export interface JobSummary {
+  id: string;
+  state: JobSummary_State;
+  details: string;
+  error: string;
+  metadata: JobMetadata | undefined;
+}
+
+export enum JobSummary_State {
+  RUNNING = 0,
+  COMPLETE = 1,
+  FAILED = 2,
+  CANCELLED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function jobSummary_StateFromJSON(object: any): JobSummary_State {
+  switch (object) {
+    case 0:
+    case "RUNNING":
+      return JobSummary_State.RUNNING;
+    case 1:
+    case "COMPLETE":
+      return JobSummary_State.COMPLETE;
+    case 2:
+    case "FAILED":
+      return JobSummary_State.FAILED;
+    case 3:
+    case "CANCELLED":
+      return JobSummary_State.CANCELLED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return JobSummary_State.UNRECOGNIZED;
+  }
+}
+
+export function jobSummary_StateToJSON(object: JobSummary_State): string {
+  switch (object) {
+    case JobSummary_State.RUNNING:
+      return "RUNNING";
+    case JobSummary_State.COMPLETE:
+      return "COMPLETE";
+    case JobSummary_State.FAILED:
+      return "FAILED";
+    case JobSummary_State.CANCELLED:
+      return "CANCELLED";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+export interface ListJobsResponse {
+  /** jobs ordered from the most recently created */
+  jobs: JobSummary[];
+}
+
+export interface JobProgress {
+  phase: JobProgress_Phase;
+  testsDone: number;
+  testsTotal: number;
+  stepsDone: number;
+  stepsTotal: number;
+  /** time spent in each phase so far in milliseconds, by phase name */
+  phaseElapsedMs: { [key: string]: number };
+  /**
+   * estimated time in milliseconds until the tests or steps currently being
+   * processed are finished, or 0 if there is no estimate yet
+   */
+  etaMs: number;
+}
+
+export enum JobProgress_Phase {
+  INITIALIZING = 0,
+  COLLECTING_COVERAGE = 1,
+  SORTING = 2,
+  BUILDING_STEP = 3,
+  PRUNING_DEAD_CODE = 4,
+  RENDERING = 5,
+  UNRECOGNIZED = -1,
+}
+
+export function jobProgress_PhaseFromJSON(object: any): JobProgress_Phase {
+  switch (object) {
+    case 0:
+    case "INITIALIZING":
+      return JobProgress_Phase.INITIALIZING;
+    case 1:
+    case "COLLECTING_COVERAGE":
+      return JobProgress_Phase.COLLECTING_COVERAGE;
+    case 2:
+    case "SORTING":
+      return JobProgress_Phase.SORTING;
+    case 3:
+    case "BUILDING_STEP":
+      return JobProgress_Phase.BUILDING_STEP;
+    case 4:
+    case "PRUNING_DEAD_CODE":
+      return JobProgress_Phase.PRUNING_DEAD_CODE;
+    case 5:
+    case "RENDERING":
+      return JobProgress_Phase.RENDERING;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return JobProgress_Phase.UNRECOGNIZED;
+  }
+}
+
+export function jobProgress_PhaseToJSON(object: JobProgress_Phase): string {
+  switch (object) {
+    case JobProgress_Phase.INITIALIZING:
+      return "INITIALIZING";
+    case JobProgress_Phase.COLLECTING_COVERAGE:
+      return "COLLECTING_COVERAGE";
+    case JobProgress_Phase.SORTING:
+      return "SORTING";
+    case JobProgress_Phase.BUILDING_STEP:
+      return "BUILDING_STEP";
+    case JobProgress_Phase.PRUNING_DEAD_CODE:
+      return "PRUNING_DEAD_CODE";
+    case JobProgress_Phase.RENDERING:
+      return "RENDERING";
+    default:
+      return "UNKNOWN";
+  }
+}
+
+export interface JobProgress_PhaseElapsedMsEntry {
+  key: string;
+  value: number;
 }
 
 export interface JobResults {
   tests: string[];
   files: FileMap[];
+  diffs: StepDiff[];
+  /** kind of function each test is, in the same order as tests */
+  kinds: TestKind[];
+  /**
+   * how running each test of the job ended, including tests left out of
+   * the log, in the order the tests were given
+   */
+  testResults: TestResult[];
+  /**
+   * tests the sort left out of the log because the tests in it already
+   * cover everything they do, in the order the tests were given
+   */
+  redundantTests: string[];
+  /**
+   * tests of the log whose coverage contains the coverage of earlier tests,
+   * in the order of the log, for CONTAINMENT sorts
+   */
+  refinements: TestRefinement[];
+  /** the chapters of the log, in order, for CHAPTERS sorts */
+  chapters: Chapter[];
+  /** what each test of the log adds at its step, in the order of the log */
+  metrics: TestMetrics[];
+}
+
+/** TestMetrics describes what a test adds at its step of a log */
+export interface TestMetrics {
+  test: string;
+  /** lines the test covers */
+  linesCovered: number;
+  /** lines the test covers that the steps before it don't */
+  newLines: number;
+  /** lines the test covers that the steps before it do too */
+  overlap: number;
+  /**
+   * average number of tests covering each line the test covers, as ranked
+   * by the IMPORTANCE sort
+   */
+  importance: number;
+}
+
+/** Chapter is a run of steps of a log whose tests cover similar code */
+export interface Chapter {
+  /** names the functions the chapter's tests cover most */
+  title: string;
+  /** the tests of the chapter, in the order of the log */
+  tests: string[];
+}
+
+/**
+ * TestRefinement records that a test covers everything some other tests do,
+ * and more
+ */
+export interface TestRefinement {
+  test: string;
+  /**
+   * the tests it directly refines, leaving out those refined by another
+   * test in the list
+   */
+  refines: string[];
+}
+
+/** TestResult is the outcome of running a single test */
+export interface TestResult {
+  test: string;
+  outcome: TestOutcome;
+  /**
+   * output is what the test printed, as reported by go test -json. Tests
+   * that fail, time out or don't build include everything go test printed
+   */
+  output: string;
+  /** how long the test took to run. Sorts only use it to break ties */
+  durationMs: number;
+  /** the subtests the test ran, in the order they started */
+  subtests: string[];
+}
+
+/**
+ * StepDiff describes the changes made to each file by a step of the log,
+ * relative to the previous step
+ */
+export interface StepDiff {
+  files: FileDiff[];
+}
+
+export interface FileDiff {
+  name: string;
+  added: number;
+  removed: number;
+  /** unified diff hunks, including hunk headers but no file headers */
+  unified: string;
+}
+
+/** JobEvent is sent on a job's event stream each time the job makes progress */
+export interface JobEvent {
+  type: JobEvent_Type;
+  /** status message, for STATUS events */
+  message: string;
+  /**
+   * index of the completed step and the test it was built from, for STEP
+   * events. The test is empty for the final step.
+   */
+  step: number;
+  test: string;
+  diff: StepDiff | undefined;
+  /** final status of the job, for DONE events */
+  status: JobStatusResponse | undefined;
+  /** progress of the job, for STATUS events */
+  progress: JobProgress | undefined;
+  /** kind of function the test is, for STEP events */
+  kind: TestKind;
+}
+
+export enum JobEvent_Type {
+  STATUS = 0,
+  STEP = 1,
+  DONE = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function jobEvent_TypeFromJSON(object: any): JobEvent_Type {
+  switch (object) {
+    case 0:
+    case "STATUS":
+      return JobEvent_Type.STATUS;
+    case 1:
+    case "STEP":
+      return JobEvent_Type.STEP;
+    case 2:
+    case "DONE":
+      return JobEvent_Type.DONE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return JobEvent_Type.UNRECOGNIZED;
+  }
+}
+
+export function jobEvent_TypeToJSON(object: JobEvent_Type): string {
+  switch (object) {
+    case JobEvent_Type.STATUS:
+      return "STATUS";
+    case JobEvent_Type.STEP:
+      return "STEP";
+    case JobEvent_Type.DONE:
+      return "DONE";
+    default:
+      return "UNKNOWN";
+  }
 }
 
 export interface FileMap {
@@ -87,7 +683,12 @@ export interface FileMap_FilesEntry {
   value: Uint8Array;
 }
 
-const baseStartJobRequest: object = { tests: "", pkg: "", sort: 0 };
+const baseStartJobRequest: object = {
+  tests: "",
+  pkg: "",
+  sort: 0,
+  failurePolicy: 0,
+};
 
 export const StartJobRequest = {
   encode(
@@ -103,6 +704,18 @@ export const StartJobRequest = {
     if (message.sort !== 0) {
       writer.uint32(24).int32(message.sort);
     }
+    if (message.options !== undefined) {
+      TestOptions.encode(message.options, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.failurePolicy !== 0) {
+      writer.uint32(40).int32(message.failurePolicy);
+    }
+    if (message.constraints !== undefined) {
+      SortConstraints.encode(
+        message.constraints,
+        writer.uint32(50).fork()
+      ).ldelim();
+    }
     return writer;
   },
 
@@ -123,6 +736,15 @@ export const StartJobRequest = {
         case 3:
           message.sort = reader.int32() as any;
           break;
+        case 4:
+          message.options = TestOptions.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.failurePolicy = reader.int32() as any;
+          break;
+        case 6:
+          message.constraints = SortConstraints.decode(reader, reader.uint32());
+          break;
         default:
           reader.skipType(tag & 7);
           break;
@@ -149,6 +771,23 @@ export const StartJobRequest = {
     } else {
       message.sort = 0;
     }
+    if (object.options !== undefined && object.options !== null) {
+      message.options = TestOptions.fromJSON(object.options);
+    } else {
+      message.options = undefined;
+    }
+    if (object.failurePolicy !== undefined && object.failurePolicy !== null) {
+      message.failurePolicy = startJobRequest_FailurePolicyFromJSON(
+        object.failurePolicy
+      );
+    } else {
+      message.failurePolicy = 0;
+    }
+    if (object.constraints !== undefined && object.constraints !== null) {
+      message.constraints = SortConstraints.fromJSON(object.constraints);
+    } else {
+      message.constraints = undefined;
+    }
     return message;
   },
 
@@ -159,56 +798,2360 @@ export const StartJobRequest = {
     } else {
       obj.tests = [];
     }
-    message.pkg !== undefined && (obj.pkg = message.pkg);
-    message.sort !== undefined &&
-      (obj.sort = startJobRequest_SortTypeToJSON(message.sort));
+    message.pkg !== undefined && (obj.pkg = message.pkg);
+    message.sort !== undefined &&
+      (obj.sort = startJobRequest_SortTypeToJSON(message.sort));
+    message.options !== undefined &&
+      (obj.options = message.options
+        ? TestOptions.toJSON(message.options)
+        : undefined);
+    message.failurePolicy !== undefined &&
+      (obj.failurePolicy = startJobRequest_FailurePolicyToJSON(
+        message.failurePolicy
+      ));
+    message.constraints !== undefined &&
+      (obj.constraints = message.constraints
+        ? SortConstraints.toJSON(message.constraints)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<StartJobRequest>): StartJobRequest {
+    const message = { ...baseStartJobRequest } as StartJobRequest;
+    message.tests = [];
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(e);
+      }
+    }
+    if (object.pkg !== undefined && object.pkg !== null) {
+      message.pkg = object.pkg;
+    } else {
+      message.pkg = "";
+    }
+    if (object.sort !== undefined && object.sort !== null) {
+      message.sort = object.sort;
+    } else {
+      message.sort = 0;
+    }
+    if (object.options !== undefined && object.options !== null) {
+      message.options = TestOptions.fromPartial(object.options);
+    } else {
+      message.options = undefined;
+    }
+    if (object.failurePolicy !== undefined && object.failurePolicy !== null) {
+      message.failurePolicy = object.failurePolicy;
+    } else {
+      message.failurePolicy = 0;
+    }
+    if (object.constraints !== undefined && object.constraints !== null) {
+      message.constraints = SortConstraints.fromPartial(object.constraints);
+    } else {
+      message.constraints = undefined;
+    }
+    return message;
+  },
+};
+
+const baseSortConstraints: object = { first: "", last: "" };
+
+export const SortConstraints = {
+  encode(
+    message: SortConstraints,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    for (const v of message.first) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.last) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.pins) {
+      SortConstraints_Pin.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    for (const v of message.orders) {
+      SortConstraints_Order.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SortConstraints {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseSortConstraints } as SortConstraints;
+    message.first = [];
+    message.last = [];
+    message.pins = [];
+    message.orders = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.first.push(reader.string());
+          break;
+        case 2:
+          message.last.push(reader.string());
+          break;
+        case 3:
+          message.pins.push(
+            SortConstraints_Pin.decode(reader, reader.uint32())
+          );
+          break;
+        case 4:
+          message.orders.push(
+            SortConstraints_Order.decode(reader, reader.uint32())
+          );
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SortConstraints {
+    const message = { ...baseSortConstraints } as SortConstraints;
+    message.first = [];
+    message.last = [];
+    message.pins = [];
+    message.orders = [];
+    if (object.first !== undefined && object.first !== null) {
+      for (const e of object.first) {
+        message.first.push(String(e));
+      }
+    }
+    if (object.last !== undefined && object.last !== null) {
+      for (const e of object.last) {
+        message.last.push(String(e));
+      }
+    }
+    if (object.pins !== undefined && object.pins !== null) {
+      for (const e of object.pins) {
+        message.pins.push(SortConstraints_Pin.fromJSON(e));
+      }
+    }
+    if (object.orders !== undefined && object.orders !== null) {
+      for (const e of object.orders) {
+        message.orders.push(SortConstraints_Order.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: SortConstraints): unknown {
+    const obj: any = {};
+    if (message.first) {
+      obj.first = message.first.map((e) => e);
+    } else {
+      obj.first = [];
+    }
+    if (message.last) {
+      obj.last = message.last.map((e) => e);
+    } else {
+      obj.last = [];
+    }
+    if (message.pins) {
+      obj.pins = message.pins.map((e) =>
+        e ? SortConstraints_Pin.toJSON(e) : undefined
+      );
+    } else {
+      obj.pins = [];
+    }
+    if (message.orders) {
+      obj.orders = message.orders.map((e) =>
+        e ? SortConstraints_Order.toJSON(e) : undefined
+      );
+    } else {
+      obj.orders = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<SortConstraints>): SortConstraints {
+    const message = { ...baseSortConstraints } as SortConstraints;
+    message.first = [];
+    message.last = [];
+    message.pins = [];
+    message.orders = [];
+    if (object.first !== undefined && object.first !== null) {
+      for (const e of object.first) {
+        message.first.push(e);
+      }
+    }
+    if (object.last !== undefined && object.last !== null) {
+      for (const e of object.last) {
+        message.last.push(e);
+      }
+    }
+    if (object.pins !== undefined && object.pins !== null) {
+      for (const e of object.pins) {
+        message.pins.push(SortConstraints_Pin.fromPartial(e));
+      }
+    }
+    if (object.orders !== undefined && object.orders !== null) {
+      for (const e of object.orders) {
+        message.orders.push(SortConstraints_Order.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseSortConstraints_Pin: object = { test: "", step: 0 };
+
+export const SortConstraints_Pin = {
+  encode(
+    message: SortConstraints_Pin,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.test !== "") {
+      writer.uint32(10).string(message.test);
+    }
+    if (message.step !== 0) {
+      writer.uint32(16).int32(message.step);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SortConstraints_Pin {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseSortConstraints_Pin } as SortConstraints_Pin;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.test = reader.string();
+          break;
+        case 2:
+          message.step = reader.int32();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SortConstraints_Pin {
+    const message = { ...baseSortConstraints_Pin } as SortConstraints_Pin;
+    if (object.test !== undefined && object.test !== null) {
+      message.test = String(object.test);
+    } else {
+      message.test = "";
+    }
+    if (object.step !== undefined && object.step !== null) {
+      message.step = Number(object.step);
+    } else {
+      message.step = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: SortConstraints_Pin): unknown {
+    const obj: any = {};
+    message.test !== undefined && (obj.test = message.test);
+    message.step !== undefined && (obj.step = message.step);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<SortConstraints_Pin>): SortConstraints_Pin {
+    const message = { ...baseSortConstraints_Pin } as SortConstraints_Pin;
+    if (object.test !== undefined && object.test !== null) {
+      message.test = object.test;
+    } else {
+      message.test = "";
+    }
+    if (object.step !== undefined && object.step !== null) {
+      message.step = object.step;
+    } else {
+      message.step = 0;
+    }
+    return message;
+  },
+};
+
+const baseSortConstraints_Order: object = { before: "", after: "" };
+
+export const SortConstraints_Order = {
+  encode(
+    message: SortConstraints_Order,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.before !== "") {
+      writer.uint32(10).string(message.before);
+    }
+    if (message.after !== "") {
+      writer.uint32(18).string(message.after);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number
+  ): SortConstraints_Order {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseSortConstraints_Order } as SortConstraints_Order;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.before = reader.string();
+          break;
+        case 2:
+          message.after = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SortConstraints_Order {
+    const message = { ...baseSortConstraints_Order } as SortConstraints_Order;
+    if (object.before !== undefined && object.before !== null) {
+      message.before = String(object.before);
+    } else {
+      message.before = "";
+    }
+    if (object.after !== undefined && object.after !== null) {
+      message.after = String(object.after);
+    } else {
+      message.after = "";
+    }
+    return message;
+  },
+
+  toJSON(message: SortConstraints_Order): unknown {
+    const obj: any = {};
+    message.before !== undefined && (obj.before = message.before);
+    message.after !== undefined && (obj.after = message.after);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<SortConstraints_Order>
+  ): SortConstraints_Order {
+    const message = { ...baseSortConstraints_Order } as SortConstraints_Order;
+    if (object.before !== undefined && object.before !== null) {
+      message.before = object.before;
+    } else {
+      message.before = "";
+    }
+    if (object.after !== undefined && object.after !== null) {
+      message.after = object.after;
+    } else {
+      message.after = "";
+    }
+    return message;
+  },
+};
+
+const baseTestOptions: object = {
+  coverPkgs: "",
+  tags: "",
+  timeout: "",
+  short: false,
+  count: 0,
+  args: "",
+  coverMode: "",
+};
+
+export const TestOptions = {
+  encode(
+    message: TestOptions,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    for (const v of message.coverPkgs) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.tags) {
+      writer.uint32(18).string(v!);
+    }
+    Object.entries(message.env).forEach(([key, value]) => {
+      TestOptions_EnvEntry.encode(
+        { key: key as any, value },
+        writer.uint32(26).fork()
+      ).ldelim();
+    });
+    if (message.timeout !== "") {
+      writer.uint32(34).string(message.timeout);
+    }
+    if (message.short === true) {
+      writer.uint32(40).bool(message.short);
+    }
+    if (message.count !== 0) {
+      writer.uint32(48).int32(message.count);
+    }
+    for (const v of message.args) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.coverMode !== "") {
+      writer.uint32(66).string(message.coverMode);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TestOptions {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseTestOptions } as TestOptions;
+    message.coverPkgs = [];
+    message.tags = [];
+    message.env = {};
+    message.args = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.coverPkgs.push(reader.string());
+          break;
+        case 2:
+          message.tags.push(reader.string());
+          break;
+        case 3:
+          const entry3 = TestOptions_EnvEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.env[entry3.key] = entry3.value;
+          }
+          break;
+        case 4:
+          message.timeout = reader.string();
+          break;
+        case 5:
+          message.short = reader.bool();
+          break;
+        case 6:
+          message.count = reader.int32();
+          break;
+        case 7:
+          message.args.push(reader.string());
+          break;
+        case 8:
+          message.coverMode = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TestOptions {
+    const message = { ...baseTestOptions } as TestOptions;
+    message.coverPkgs = [];
+    message.tags = [];
+    message.env = {};
+    message.args = [];
+    if (object.coverPkgs !== undefined && object.coverPkgs !== null) {
+      for (const e of object.coverPkgs) {
+        message.coverPkgs.push(String(e));
+      }
+    }
+    if (object.tags !== undefined && object.tags !== null) {
+      for (const e of object.tags) {
+        message.tags.push(String(e));
+      }
+    }
+    if (object.env !== undefined && object.env !== null) {
+      Object.entries(object.env).forEach(([key, value]) => {
+        message.env[key] = String(value);
+      });
+    }
+    if (object.timeout !== undefined && object.timeout !== null) {
+      message.timeout = String(object.timeout);
+    } else {
+      message.timeout = "";
+    }
+    if (object.short !== undefined && object.short !== null) {
+      message.short = Boolean(object.short);
+    } else {
+      message.short = false;
+    }
+    if (object.count !== undefined && object.count !== null) {
+      message.count = Number(object.count);
+    } else {
+      message.count = 0;
+    }
+    if (object.args !== undefined && object.args !== null) {
+      for (const e of object.args) {
+        message.args.push(String(e));
+      }
+    }
+    if (object.coverMode !== undefined && object.coverMode !== null) {
+      message.coverMode = String(object.coverMode);
+    } else {
+      message.coverMode = "";
+    }
+    return message;
+  },
+
+  toJSON(message: TestOptions): unknown {
+    const obj: any = {};
+    if (message.coverPkgs) {
+      obj.coverPkgs = message.coverPkgs.map((e) => e);
+    } else {
+      obj.coverPkgs = [];
+    }
+    if (message.tags) {
+      obj.tags = message.tags.map((e) => e);
+    } else {
+      obj.tags = [];
+    }
+    obj.env = {};
+    if (message.env) {
+      Object.entries(message.env).forEach(([k, v]) => {
+        obj.env[k] = v;
+      });
+    }
+    message.timeout !== undefined && (obj.timeout = message.timeout);
+    message.short !== undefined && (obj.short = message.short);
+    message.count !== undefined && (obj.count = message.count);
+    if (message.args) {
+      obj.args = message.args.map((e) => e);
+    } else {
+      obj.args = [];
+    }
+    message.coverMode !== undefined && (obj.coverMode = message.coverMode);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<TestOptions>): TestOptions {
+    const message = { ...baseTestOptions } as TestOptions;
+    message.coverPkgs = [];
+    message.tags = [];
+    message.env = {};
+    message.args = [];
+    if (object.coverPkgs !== undefined && object.coverPkgs !== null) {
+      for (const e of object.coverPkgs) {
+        message.coverPkgs.push(e);
+      }
+    }
+    if (object.tags !== undefined && object.tags !== null) {
+      for (const e of object.tags) {
+        message.tags.push(e);
+      }
+    }
+    if (object.env !== undefined && object.env !== null) {
+      Object.entries(object.env).forEach(([key, value]) => {
+        if (value !== undefined) {
+          message.env[key] = String(value);
+        }
+      });
+    }
+    if (object.timeout !== undefined && object.timeout !== null) {
+      message.timeout = object.timeout;
+    } else {
+      message.timeout = "";
+    }
+    if (object.short !== undefined && object.short !== null) {
+      message.short = object.short;
+    } else {
+      message.short = false;
+    }
+    if (object.count !== undefined && object.count !== null) {
+      message.count = object.count;
+    } else {
+      message.count = 0;
+    }
+    if (object.args !== undefined && object.args !== null) {
+      for (const e of object.args) {
+        message.args.push(e);
+      }
+    }
+    if (object.coverMode !== undefined && object.coverMode !== null) {
+      message.coverMode = object.coverMode;
+    } else {
+      message.coverMode = "";
+    }
+    return message;
+  },
+};
+
+const baseTestOptions_EnvEntry: object = { key: "", value: "" };
+
+export const TestOptions_EnvEntry = {
+  encode(
+    message: TestOptions_EnvEntry,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number
+  ): TestOptions_EnvEntry {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseTestOptions_EnvEntry } as TestOptions_EnvEntry;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.key = reader.string();
+          break;
+        case 2:
+          message.value = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TestOptions_EnvEntry {
+    const message = { ...baseTestOptions_EnvEntry } as TestOptions_EnvEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = String(object.key);
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = String(object.value);
+    } else {
+      message.value = "";
+    }
+    return message;
+  },
+
+  toJSON(message: TestOptions_EnvEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<TestOptions_EnvEntry>): TestOptions_EnvEntry {
+    const message = { ...baseTestOptions_EnvEntry } as TestOptions_EnvEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = object.key;
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = object.value;
+    } else {
+      message.value = "";
+    }
+    return message;
+  },
+};
+
+const baseStartJobResponse: object = { id: "" };
+
+export const StartJobResponse = {
+  encode(
+    message: StartJobResponse,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StartJobResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseStartJobResponse } as StartJobResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StartJobResponse {
+    const message = { ...baseStartJobResponse } as StartJobResponse;
+    if (object.id !== undefined && object.id !== null) {
+      message.id = String(object.id);
+    } else {
+      message.id = "";
+    }
+    return message;
+  },
+
+  toJSON(message: StartJobResponse): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<StartJobResponse>): StartJobResponse {
+    const message = { ...baseStartJobResponse } as StartJobResponse;
+    if (object.id !== undefined && object.id !== null) {
+      message.id = object.id;
+    } else {
+      message.id = "";
+    }
+    return message;
+  },
+};
+
+const baseCheckoutFilesRequest: object = {};
+
+export const CheckoutFilesRequest = {
+  encode(
+    message: CheckoutFilesRequest,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.files !== undefined) {
+      FileMap.encode(message.files, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number
+  ): CheckoutFilesRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseCheckoutFilesRequest } as CheckoutFilesRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.files = FileMap.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CheckoutFilesRequest {
+    const message = { ...baseCheckoutFilesRequest } as CheckoutFilesRequest;
+    if (object.files !== undefined && object.files !== null) {
+      message.files = FileMap.fromJSON(object.files);
+    } else {
+      message.files = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: CheckoutFilesRequest): unknown {
+    const obj: any = {};
+    message.files !== undefined &&
+      (obj.files = message.files ? FileMap.toJSON(message.files) : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<CheckoutFilesRequest>): CheckoutFilesRequest {
+    const message = { ...baseCheckoutFilesRequest } as CheckoutFilesRequest;
+    if (object.files !== undefined && object.files !== null) {
+      message.files = FileMap.fromPartial(object.files);
+    } else {
+      message.files = undefined;
+    }
+    return message;
+  },
+};
+
+const baseExportRepositoryRequest: object = { dir: "" };
+
+export const ExportRepositoryRequest = {
+  encode(
+    message: ExportRepositoryRequest,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.dir !== "") {
+      writer.uint32(10).string(message.dir);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number
+  ): ExportRepositoryRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseExportRepositoryRequest,
+    } as ExportRepositoryRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.dir = reader.string();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportRepositoryRequest {
+    const message = {
+      ...baseExportRepositoryRequest,
+    } as ExportRepositoryRequest;
+    if (object.dir !== undefined && object.dir !== null) {
+      message.dir = String(object.dir);
+    } else {
+      message.dir = "";
+    }
+    return message;
+  },
+
+  toJSON(message: ExportRepositoryRequest): unknown {
+    const obj: any = {};
+    message.dir !== undefined && (obj.dir = message.dir);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<ExportRepositoryRequest>
+  ): ExportRepositoryRequest {
+    const message = {
+      ...baseExportRepositoryRequest,
+    } as ExportRepositoryRequest;
+    if (object.dir !== undefined && object.dir !== null) {
+      message.dir = object.dir;
+    } else {
+      message.dir = "";
+    }
+    return message;
+  },
+};
+
+const baseListTestsRequest: object = { pkg: "" };
+
+export const ListTestsRequest = {
+  encode(
+    message: ListTestsRequest,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.pkg !== "") {
+      writer.uint32(10).string(message.pkg);
+    }
+    if (message.options !== undefined) {
+      TestOptions.encode(message.options, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListTestsRequest {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseListTestsRequest } as ListTestsRequest;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.pkg = reader.string();
+          break;
+        case 2:
+          message.options = TestOptions.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListTestsRequest {
+    const message = { ...baseListTestsRequest } as ListTestsRequest;
+    if (object.pkg !== undefined && object.pkg !== null) {
+      message.pkg = String(object.pkg);
+    } else {
+      message.pkg = "";
+    }
+    if (object.options !== undefined && object.options !== null) {
+      message.options = TestOptions.fromJSON(object.options);
+    } else {
+      message.options = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: ListTestsRequest): unknown {
+    const obj: any = {};
+    message.pkg !== undefined && (obj.pkg = message.pkg);
+    message.options !== undefined &&
+      (obj.options = message.options
+        ? TestOptions.toJSON(message.options)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ListTestsRequest>): ListTestsRequest {
+    const message = { ...baseListTestsRequest } as ListTestsRequest;
+    if (object.pkg !== undefined && object.pkg !== null) {
+      message.pkg = object.pkg;
+    } else {
+      message.pkg = "";
+    }
+    if (object.options !== undefined && object.options !== null) {
+      message.options = TestOptions.fromPartial(object.options);
+    } else {
+      message.options = undefined;
+    }
+    return message;
+  },
+};
+
+const baseJobStatusResponse: object = {
+  complete: false,
+  details: "",
+  error: "",
+  cancelled: false,
+};
+
+export const JobStatusResponse = {
+  encode(
+    message: JobStatusResponse,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.complete === true) {
+      writer.uint32(8).bool(message.complete);
+    }
+    if (message.details !== "") {
+      writer.uint32(18).string(message.details);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    if (message.results !== undefined) {
+      JobResults.encode(message.results, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.cancelled === true) {
+      writer.uint32(40).bool(message.cancelled);
+    }
+    if (message.progress !== undefined) {
+      JobProgress.encode(message.progress, writer.uint32(50).fork()).ldelim();
+    }
+    if (message.metadata !== undefined) {
+      JobMetadata.encode(message.metadata, writer.uint32(58).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): JobStatusResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseJobStatusResponse } as JobStatusResponse;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.complete = reader.bool();
+          break;
+        case 2:
+          message.details = reader.string();
+          break;
+        case 3:
+          message.error = reader.string();
+          break;
+        case 4:
+          message.results = JobResults.decode(reader, reader.uint32());
+          break;
+        case 5:
+          message.cancelled = reader.bool();
+          break;
+        case 6:
+          message.progress = JobProgress.decode(reader, reader.uint32());
+          break;
+        case 7:
+          message.metadata = JobMetadata.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobStatusResponse {
+    const message = { ...baseJobStatusResponse } as JobStatusResponse;
+    if (object.complete !== undefined && object.complete !== null) {
+      message.complete = Boolean(object.complete);
+    } else {
+      message.complete = false;
+    }
+    if (object.details !== undefined && object.details !== null) {
+      message.details = String(object.details);
+    } else {
+      message.details = "";
+    }
+    if (object.error !== undefined && object.error !== null) {
+      message.error = String(object.error);
+    } else {
+      message.error = "";
+    }
+    if (object.results !== undefined && object.results !== null) {
+      message.results = JobResults.fromJSON(object.results);
+    } else {
+      message.results = undefined;
+    }
+    if (object.cancelled !== undefined && object.cancelled !== null) {
+      message.cancelled = Boolean(object.cancelled);
+    } else {
+      message.cancelled = false;
+    }
+    if (object.progress !== undefined && object.progress !== null) {
+      message.progress = JobProgress.fromJSON(object.progress);
+    } else {
+      message.progress = undefined;
+    }
+    if (object.metadata !== undefined && object.metadata !== null) {
+      message.metadata = JobMetadata.fromJSON(object.metadata);
+    } else {
+      message.metadata = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: JobStatusResponse): unknown {
+    const obj: any = {};
+    message.complete !== undefined && (obj.complete = message.complete);
+    message.details !== undefined && (obj.details = message.details);
+    message.error !== undefined && (obj.error = message.error);
+    message.results !== undefined &&
+      (obj.results = message.results
+        ? JobResults.toJSON(message.results)
+        : undefined);
+    message.cancelled !== undefined && (obj.cancelled = message.cancelled);
+    message.progress !== undefined &&
+      (obj.progress = message.progress
+        ? JobProgress.toJSON(message.progress)
+        : undefined);
+    message.metadata !== undefined &&
+      (obj.metadata = message.metadata
+        ? JobMetadata.toJSON(message.metadata)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<JobStatusResponse>): JobStatusResponse {
+    const message = { ...baseJobStatusResponse } as JobStatusResponse;
+    if (object.complete !== undefined && object.complete !== null) {
+      message.complete = object.complete;
+    } else {
+      message.complete = false;
+    }
+    if (object.details !== undefined && object.details !== null) {
+      message.details = object.details;
+    } else {
+      message.details = "";
+    }
+    if (object.error !== undefined && object.error !== null) {
+      message.error = object.error;
+    } else {
+      message.error = "";
+    }
+    if (object.results !== undefined && object.results !== null) {
+      message.results = JobResults.fromPartial(object.results);
+    } else {
+      message.results = undefined;
+    }
+    if (object.cancelled !== undefined && object.cancelled !== null) {
+      message.cancelled = object.cancelled;
+    } else {
+      message.cancelled = false;
+    }
+    if (object.progress !== undefined && object.progress !== null) {
+      message.progress = JobProgress.fromPartial(object.progress);
+    } else {
+      message.progress = undefined;
+    }
+    if (object.metadata !== undefined && object.metadata !== null) {
+      message.metadata = JobMetadata.fromPartial(object.metadata);
+    } else {
+      message.metadata = undefined;
+    }
+    return message;
+  },
+};
+
+const baseJobMetadata: object = {
+  pkg: "",
+  sort: 0,
+  tests: "",
+  createdAtMs: 0,
+  finishedAtMs: 0,
+  durationMs: 0,
+};
+
+export const JobMetadata = {
+  encode(
+    message: JobMetadata,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.pkg !== "") {
+      writer.uint32(10).string(message.pkg);
+    }
+    if (message.sort !== 0) {
+      writer.uint32(16).int32(message.sort);
+    }
+    for (const v of message.tests) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.createdAtMs !== 0) {
+      writer.uint32(32).int64(message.createdAtMs);
+    }
+    if (message.finishedAtMs !== 0) {
+      writer.uint32(40).int64(message.finishedAtMs);
+    }
+    if (message.durationMs !== 0) {
+      writer.uint32(48).int64(message.durationMs);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): JobMetadata {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseJobMetadata } as JobMetadata;
+    message.tests = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.pkg = reader.string();
+          break;
+        case 2:
+          message.sort = reader.int32() as any;
+          break;
+        case 3:
+          message.tests.push(reader.string());
+          break;
+        case 4:
+          message.createdAtMs = longToNumber(reader.int64() as Long);
+          break;
+        case 5:
+          message.finishedAtMs = longToNumber(reader.int64() as Long);
+          break;
+        case 6:
+          message.durationMs = longToNumber(reader.int64() as Long);
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobMetadata {
+    const message = { ...baseJobMetadata } as JobMetadata;
+    message.tests = [];
+    if (object.pkg !== undefined && object.pkg !== null) {
+      message.pkg = String(object.pkg);
+    } else {
+      message.pkg = "";
+    }
+    if (object.sort !== undefined && object.sort !== null) {
+      message.sort = startJobRequest_SortTypeFromJSON(object.sort);
+    } else {
+      message.sort = 0;
+    }
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(String(e));
+      }
+    }
+    if (object.createdAtMs !== undefined && object.createdAtMs !== null) {
+      message.createdAtMs = Number(object.createdAtMs);
+    } else {
+      message.createdAtMs = 0;
+    }
+    if (object.finishedAtMs !== undefined && object.finishedAtMs !== null) {
+      message.finishedAtMs = Number(object.finishedAtMs);
+    } else {
+      message.finishedAtMs = 0;
+    }
+    if (object.durationMs !== undefined && object.durationMs !== null) {
+      message.durationMs = Number(object.durationMs);
+    } else {
+      message.durationMs = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: JobMetadata): unknown {
+    const obj: any = {};
+    message.pkg !== undefined && (obj.pkg = message.pkg);
+    message.sort !== undefined &&
+      (obj.sort = startJobRequest_SortTypeToJSON(message.sort));
+    if (message.tests) {
+      obj.tests = message.tests.map((e) => e);
+    } else {
+      obj.tests = [];
+    }
+    message.createdAtMs !== undefined &&
+      (obj.createdAtMs = message.createdAtMs);
+    message.finishedAtMs !== undefined &&
+      (obj.finishedAtMs = message.finishedAtMs);
+    message.durationMs !== undefined && (obj.durationMs = message.durationMs);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<JobMetadata>): JobMetadata {
+    const message = { ...baseJobMetadata } as JobMetadata;
+    message.tests = [];
+    if (object.pkg !== undefined && object.pkg !== null) {
+      message.pkg = object.pkg;
+    } else {
+      message.pkg = "";
+    }
+    if (object.sort !== undefined && object.sort !== null) {
+      message.sort = object.sort;
+    } else {
+      message.sort = 0;
+    }
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(e);
+      }
+    }
+    if (object.createdAtMs !== undefined && object.createdAtMs !== null) {
+      message.createdAtMs = object.createdAtMs;
+    } else {
+      message.createdAtMs = 0;
+    }
+    if (object.finishedAtMs !== undefined && object.finishedAtMs !== null) {
+      message.finishedAtMs = object.finishedAtMs;
+    } else {
+      message.finishedAtMs = 0;
+    }
+    if (object.durationMs !== undefined && object.durationMs !== null) {
+      message.durationMs = object.durationMs;
+    } else {
+      message.durationMs = 0;
+    }
+    return message;
+  },
+};
+
+const baseJobSummary: object = { id: "", state: 0, details: "", error: "" };
+
+export const JobSummary = {
+  encode(
+    message: JobSummary,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.state !== 0) {
+      writer.uint32(16).int32(message.state);
+    }
+    if (message.details !== "") {
+      writer.uint32(26).string(message.details);
+    }
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    if (message.metadata !== undefined) {
+      JobMetadata.encode(message.metadata, writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): JobSummary {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseJobSummary } as JobSummary;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.string();
+          break;
+        case 2:
+          message.state = reader.int32() as any;
+          break;
+        case 3:
+          message.details = reader.string();
+          break;
+        case 4:
+          message.error = reader.string();
+          break;
+        case 5:
+          message.metadata = JobMetadata.decode(reader, reader.uint32());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobSummary {
+    const message = { ...baseJobSummary } as JobSummary;
+    if (object.id !== undefined && object.id !== null) {
+      message.id = String(object.id);
+    } else {
+      message.id = "";
+    }
+    if (object.state !== undefined && object.state !== null) {
+      message.state = jobSummary_StateFromJSON(object.state);
+    } else {
+      message.state = 0;
+    }
+    if (object.details !== undefined && object.details !== null) {
+      message.details = String(object.details);
+    } else {
+      message.details = "";
+    }
+    if (object.error !== undefined && object.error !== null) {
+      message.error = String(object.error);
+    } else {
+      message.error = "";
+    }
+    if (object.metadata !== undefined && object.metadata !== null) {
+      message.metadata = JobMetadata.fromJSON(object.metadata);
+    } else {
+      message.metadata = undefined;
+    }
+    return message;
+  },
+
+  toJSON(message: JobSummary): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.state !== undefined &&
+      (obj.state = jobSummary_StateToJSON(message.state));
+    message.details !== undefined && (obj.details = message.details);
+    message.error !== undefined && (obj.error = message.error);
+    message.metadata !== undefined &&
+      (obj.metadata = message.metadata
+        ? JobMetadata.toJSON(message.metadata)
+        : undefined);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<JobSummary>): JobSummary {
+    const message = { ...baseJobSummary } as JobSummary;
+    if (object.id !== undefined && object.id !== null) {
+      message.id = object.id;
+    } else {
+      message.id = "";
+    }
+    if (object.state !== undefined && object.state !== null) {
+      message.state = object.state;
+    } else {
+      message.state = 0;
+    }
+    if (object.details !== undefined && object.details !== null) {
+      message.details = object.details;
+    } else {
+      message.details = "";
+    }
+    if (object.error !== undefined && object.error !== null) {
+      message.error = object.error;
+    } else {
+      message.error = "";
+    }
+    if (object.metadata !== undefined && object.metadata !== null) {
+      message.metadata = JobMetadata.fromPartial(object.metadata);
+    } else {
+      message.metadata = undefined;
+    }
+    return message;
+  },
+};
+
+const baseListJobsResponse: object = {};
+
+export const ListJobsResponse = {
+  encode(
+    message: ListJobsResponse,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    for (const v of message.jobs) {
+      JobSummary.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListJobsResponse {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseListJobsResponse } as ListJobsResponse;
+    message.jobs = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.jobs.push(JobSummary.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListJobsResponse {
+    const message = { ...baseListJobsResponse } as ListJobsResponse;
+    message.jobs = [];
+    if (object.jobs !== undefined && object.jobs !== null) {
+      for (const e of object.jobs) {
+        message.jobs.push(JobSummary.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: ListJobsResponse): unknown {
+    const obj: any = {};
+    if (message.jobs) {
+      obj.jobs = message.jobs.map((e) =>
+        e ? JobSummary.toJSON(e) : undefined
+      );
+    } else {
+      obj.jobs = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<ListJobsResponse>): ListJobsResponse {
+    const message = { ...baseListJobsResponse } as ListJobsResponse;
+    message.jobs = [];
+    if (object.jobs !== undefined && object.jobs !== null) {
+      for (const e of object.jobs) {
+        message.jobs.push(JobSummary.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseJobProgress: object = {
+  phase: 0,
+  testsDone: 0,
+  testsTotal: 0,
+  stepsDone: 0,
+  stepsTotal: 0,
+  etaMs: 0,
+};
+
+export const JobProgress = {
+  encode(
+    message: JobProgress,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.phase !== 0) {
+      writer.uint32(8).int32(message.phase);
+    }
+    if (message.testsDone !== 0) {
+      writer.uint32(16).int32(message.testsDone);
+    }
+    if (message.testsTotal !== 0) {
+      writer.uint32(24).int32(message.testsTotal);
+    }
+    if (message.stepsDone !== 0) {
+      writer.uint32(32).int32(message.stepsDone);
+    }
+    if (message.stepsTotal !== 0) {
+      writer.uint32(40).int32(message.stepsTotal);
+    }
+    Object.entries(message.phaseElapsedMs).forEach(([key, value]) => {
+      JobProgress_PhaseElapsedMsEntry.encode(
+        { key: key as any, value },
+        writer.uint32(50).fork()
+      ).ldelim();
+    });
+    if (message.etaMs !== 0) {
+      writer.uint32(56).int64(message.etaMs);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): JobProgress {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseJobProgress } as JobProgress;
+    message.phaseElapsedMs = {};
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.phase = reader.int32() as any;
+          break;
+        case 2:
+          message.testsDone = reader.int32();
+          break;
+        case 3:
+          message.testsTotal = reader.int32();
+          break;
+        case 4:
+          message.stepsDone = reader.int32();
+          break;
+        case 5:
+          message.stepsTotal = reader.int32();
+          break;
+        case 6:
+          const entry6 = JobProgress_PhaseElapsedMsEntry.decode(
+            reader,
+            reader.uint32()
+          );
+          if (entry6.value !== undefined) {
+            message.phaseElapsedMs[entry6.key] = entry6.value;
+          }
+          break;
+        case 7:
+          message.etaMs = longToNumber(reader.int64() as Long);
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobProgress {
+    const message = { ...baseJobProgress } as JobProgress;
+    message.phaseElapsedMs = {};
+    if (object.phase !== undefined && object.phase !== null) {
+      message.phase = jobProgress_PhaseFromJSON(object.phase);
+    } else {
+      message.phase = 0;
+    }
+    if (object.testsDone !== undefined && object.testsDone !== null) {
+      message.testsDone = Number(object.testsDone);
+    } else {
+      message.testsDone = 0;
+    }
+    if (object.testsTotal !== undefined && object.testsTotal !== null) {
+      message.testsTotal = Number(object.testsTotal);
+    } else {
+      message.testsTotal = 0;
+    }
+    if (object.stepsDone !== undefined && object.stepsDone !== null) {
+      message.stepsDone = Number(object.stepsDone);
+    } else {
+      message.stepsDone = 0;
+    }
+    if (object.stepsTotal !== undefined && object.stepsTotal !== null) {
+      message.stepsTotal = Number(object.stepsTotal);
+    } else {
+      message.stepsTotal = 0;
+    }
+    if (object.phaseElapsedMs !== undefined && object.phaseElapsedMs !== null) {
+      Object.entries(object.phaseElapsedMs).forEach(([key, value]) => {
+        message.phaseElapsedMs[key] = Number(value);
+      });
+    }
+    if (object.etaMs !== undefined && object.etaMs !== null) {
+      message.etaMs = Number(object.etaMs);
+    } else {
+      message.etaMs = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: JobProgress): unknown {
+    const obj: any = {};
+    message.phase !== undefined &&
+      (obj.phase = jobProgress_PhaseToJSON(message.phase));
+    message.testsDone !== undefined && (obj.testsDone = message.testsDone);
+    message.testsTotal !== undefined && (obj.testsTotal = message.testsTotal);
+    message.stepsDone !== undefined && (obj.stepsDone = message.stepsDone);
+    message.stepsTotal !== undefined && (obj.stepsTotal = message.stepsTotal);
+    obj.phaseElapsedMs = {};
+    if (message.phaseElapsedMs) {
+      Object.entries(message.phaseElapsedMs).forEach(([k, v]) => {
+        obj.phaseElapsedMs[k] = v;
+      });
+    }
+    message.etaMs !== undefined && (obj.etaMs = message.etaMs);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<JobProgress>): JobProgress {
+    const message = { ...baseJobProgress } as JobProgress;
+    message.phaseElapsedMs = {};
+    if (object.phase !== undefined && object.phase !== null) {
+      message.phase = object.phase;
+    } else {
+      message.phase = 0;
+    }
+    if (object.testsDone !== undefined && object.testsDone !== null) {
+      message.testsDone = object.testsDone;
+    } else {
+      message.testsDone = 0;
+    }
+    if (object.testsTotal !== undefined && object.testsTotal !== null) {
+      message.testsTotal = object.testsTotal;
+    } else {
+      message.testsTotal = 0;
+    }
+    if (object.stepsDone !== undefined && object.stepsDone !== null) {
+      message.stepsDone = object.stepsDone;
+    } else {
+      message.stepsDone = 0;
+    }
+    if (object.stepsTotal !== undefined && object.stepsTotal !== null) {
+      message.stepsTotal = object.stepsTotal;
+    } else {
+      message.stepsTotal = 0;
+    }
+    if (object.phaseElapsedMs !== undefined && object.phaseElapsedMs !== null) {
+      Object.entries(object.phaseElapsedMs).forEach(([key, value]) => {
+        if (value !== undefined) {
+          message.phaseElapsedMs[key] = Number(value);
+        }
+      });
+    }
+    if (object.etaMs !== undefined && object.etaMs !== null) {
+      message.etaMs = object.etaMs;
+    } else {
+      message.etaMs = 0;
+    }
+    return message;
+  },
+};
+
+const baseJobProgress_PhaseElapsedMsEntry: object = { key: "", value: 0 };
+
+export const JobProgress_PhaseElapsedMsEntry = {
+  encode(
+    message: JobProgress_PhaseElapsedMsEntry,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== 0) {
+      writer.uint32(16).int64(message.value);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number
+  ): JobProgress_PhaseElapsedMsEntry {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = {
+      ...baseJobProgress_PhaseElapsedMsEntry,
+    } as JobProgress_PhaseElapsedMsEntry;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.key = reader.string();
+          break;
+        case 2:
+          message.value = longToNumber(reader.int64() as Long);
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobProgress_PhaseElapsedMsEntry {
+    const message = {
+      ...baseJobProgress_PhaseElapsedMsEntry,
+    } as JobProgress_PhaseElapsedMsEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = String(object.key);
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = Number(object.value);
+    } else {
+      message.value = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: JobProgress_PhaseElapsedMsEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  fromPartial(
+    object: DeepPartial<JobProgress_PhaseElapsedMsEntry>
+  ): JobProgress_PhaseElapsedMsEntry {
+    const message = {
+      ...baseJobProgress_PhaseElapsedMsEntry,
+    } as JobProgress_PhaseElapsedMsEntry;
+    if (object.key !== undefined && object.key !== null) {
+      message.key = object.key;
+    } else {
+      message.key = "";
+    }
+    if (object.value !== undefined && object.value !== null) {
+      message.value = object.value;
+    } else {
+      message.value = 0;
+    }
+    return message;
+  },
+};
+
+const baseJobResults: object = { tests: "", kinds: 0, redundantTests: "" };
+
+export const JobResults = {
+  encode(
+    message: JobResults,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    for (const v of message.tests) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.files) {
+      FileMap.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.diffs) {
+      StepDiff.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    writer.uint32(34).fork();
+    for (const v of message.kinds) {
+      writer.int32(v);
+    }
+    writer.ldelim();
+    for (const v of message.testResults) {
+      TestResult.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    for (const v of message.redundantTests) {
+      writer.uint32(50).string(v!);
+    }
+    for (const v of message.refinements) {
+      TestRefinement.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
+    for (const v of message.chapters) {
+      Chapter.encode(v!, writer.uint32(66).fork()).ldelim();
+    }
+    for (const v of message.metrics) {
+      TestMetrics.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): JobResults {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseJobResults } as JobResults;
+    message.tests = [];
+    message.files = [];
+    message.diffs = [];
+    message.kinds = [];
+    message.testResults = [];
+    message.redundantTests = [];
+    message.refinements = [];
+    message.chapters = [];
+    message.metrics = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.tests.push(reader.string());
+          break;
+        case 2:
+          message.files.push(FileMap.decode(reader, reader.uint32()));
+          break;
+        case 3:
+          message.diffs.push(StepDiff.decode(reader, reader.uint32()));
+          break;
+        case 4:
+          if ((tag & 7) === 2) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.kinds.push(reader.int32() as any);
+            }
+          } else {
+            message.kinds.push(reader.int32() as any);
+          }
+          break;
+        case 5:
+          message.testResults.push(TestResult.decode(reader, reader.uint32()));
+          break;
+        case 6:
+          message.redundantTests.push(reader.string());
+          break;
+        case 7:
+          message.refinements.push(
+            TestRefinement.decode(reader, reader.uint32())
+          );
+          break;
+        case 8:
+          message.chapters.push(Chapter.decode(reader, reader.uint32()));
+          break;
+        case 9:
+          message.metrics.push(TestMetrics.decode(reader, reader.uint32()));
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): JobResults {
+    const message = { ...baseJobResults } as JobResults;
+    message.tests = [];
+    message.files = [];
+    message.diffs = [];
+    message.kinds = [];
+    message.testResults = [];
+    message.redundantTests = [];
+    message.refinements = [];
+    message.chapters = [];
+    message.metrics = [];
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(String(e));
+      }
+    }
+    if (object.files !== undefined && object.files !== null) {
+      for (const e of object.files) {
+        message.files.push(FileMap.fromJSON(e));
+      }
+    }
+    if (object.diffs !== undefined && object.diffs !== null) {
+      for (const e of object.diffs) {
+        message.diffs.push(StepDiff.fromJSON(e));
+      }
+    }
+    if (object.kinds !== undefined && object.kinds !== null) {
+      for (const e of object.kinds) {
+        message.kinds.push(testKindFromJSON(e));
+      }
+    }
+    if (object.testResults !== undefined && object.testResults !== null) {
+      for (const e of object.testResults) {
+        message.testResults.push(TestResult.fromJSON(e));
+      }
+    }
+    if (object.redundantTests !== undefined && object.redundantTests !== null) {
+      for (const e of object.redundantTests) {
+        message.redundantTests.push(String(e));
+      }
+    }
+    if (object.refinements !== undefined && object.refinements !== null) {
+      for (const e of object.refinements) {
+        message.refinements.push(TestRefinement.fromJSON(e));
+      }
+    }
+    if (object.chapters !== undefined && object.chapters !== null) {
+      for (const e of object.chapters) {
+        message.chapters.push(Chapter.fromJSON(e));
+      }
+    }
+    if (object.metrics !== undefined && object.metrics !== null) {
+      for (const e of object.metrics) {
+        message.metrics.push(TestMetrics.fromJSON(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: JobResults): unknown {
+    const obj: any = {};
+    if (message.tests) {
+      obj.tests = message.tests.map((e) => e);
+    } else {
+      obj.tests = [];
+    }
+    if (message.files) {
+      obj.files = message.files.map((e) => (e ? FileMap.toJSON(e) : undefined));
+    } else {
+      obj.files = [];
+    }
+    if (message.diffs) {
+      obj.diffs = message.diffs.map((e) =>
+        e ? StepDiff.toJSON(e) : undefined
+      );
+    } else {
+      obj.diffs = [];
+    }
+    if (message.kinds) {
+      obj.kinds = message.kinds.map((e) => testKindToJSON(e));
+    } else {
+      obj.kinds = [];
+    }
+    if (message.testResults) {
+      obj.testResults = message.testResults.map((e) =>
+        e ? TestResult.toJSON(e) : undefined
+      );
+    } else {
+      obj.testResults = [];
+    }
+    if (message.redundantTests) {
+      obj.redundantTests = message.redundantTests.map((e) => e);
+    } else {
+      obj.redundantTests = [];
+    }
+    if (message.refinements) {
+      obj.refinements = message.refinements.map((e) =>
+        e ? TestRefinement.toJSON(e) : undefined
+      );
+    } else {
+      obj.refinements = [];
+    }
+    if (message.chapters) {
+      obj.chapters = message.chapters.map((e) =>
+        e ? Chapter.toJSON(e) : undefined
+      );
+    } else {
+      obj.chapters = [];
+    }
+    if (message.metrics) {
+      obj.metrics = message.metrics.map((e) =>
+        e ? TestMetrics.toJSON(e) : undefined
+      );
+    } else {
+      obj.metrics = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<JobResults>): JobResults {
+    const message = { ...baseJobResults } as JobResults;
+    message.tests = [];
+    message.files = [];
+    message.diffs = [];
+    message.kinds = [];
+    message.testResults = [];
+    message.redundantTests = [];
+    message.refinements = [];
+    message.chapters = [];
+    message.metrics = [];
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(e);
+      }
+    }
+    if (object.files !== undefined && object.files !== null) {
+      for (const e of object.files) {
+        message.files.push(FileMap.fromPartial(e));
+      }
+    }
+    if (object.diffs !== undefined && object.diffs !== null) {
+      for (const e of object.diffs) {
+        message.diffs.push(StepDiff.fromPartial(e));
+      }
+    }
+    if (object.kinds !== undefined && object.kinds !== null) {
+      for (const e of object.kinds) {
+        message.kinds.push(e);
+      }
+    }
+    if (object.testResults !== undefined && object.testResults !== null) {
+      for (const e of object.testResults) {
+        message.testResults.push(TestResult.fromPartial(e));
+      }
+    }
+    if (object.redundantTests !== undefined && object.redundantTests !== null) {
+      for (const e of object.redundantTests) {
+        message.redundantTests.push(e);
+      }
+    }
+    if (object.refinements !== undefined && object.refinements !== null) {
+      for (const e of object.refinements) {
+        message.refinements.push(TestRefinement.fromPartial(e));
+      }
+    }
+    if (object.chapters !== undefined && object.chapters !== null) {
+      for (const e of object.chapters) {
+        message.chapters.push(Chapter.fromPartial(e));
+      }
+    }
+    if (object.metrics !== undefined && object.metrics !== null) {
+      for (const e of object.metrics) {
+        message.metrics.push(TestMetrics.fromPartial(e));
+      }
+    }
+    return message;
+  },
+};
+
+const baseTestMetrics: object = {
+  test: "",
+  linesCovered: 0,
+  newLines: 0,
+  overlap: 0,
+  importance: 0,
+};
+
+export const TestMetrics = {
+  encode(
+    message: TestMetrics,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.test !== "") {
+      writer.uint32(10).string(message.test);
+    }
+    if (message.linesCovered !== 0) {
+      writer.uint32(16).int32(message.linesCovered);
+    }
+    if (message.newLines !== 0) {
+      writer.uint32(24).int32(message.newLines);
+    }
+    if (message.overlap !== 0) {
+      writer.uint32(32).int32(message.overlap);
+    }
+    if (message.importance !== 0) {
+      writer.uint32(41).double(message.importance);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TestMetrics {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseTestMetrics } as TestMetrics;
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.test = reader.string();
+          break;
+        case 2:
+          message.linesCovered = reader.int32();
+          break;
+        case 3:
+          message.newLines = reader.int32();
+          break;
+        case 4:
+          message.overlap = reader.int32();
+          break;
+        case 5:
+          message.importance = reader.double();
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TestMetrics {
+    const message = { ...baseTestMetrics } as TestMetrics;
+    if (object.test !== undefined && object.test !== null) {
+      message.test = String(object.test);
+    } else {
+      message.test = "";
+    }
+    if (object.linesCovered !== undefined && object.linesCovered !== null) {
+      message.linesCovered = Number(object.linesCovered);
+    } else {
+      message.linesCovered = 0;
+    }
+    if (object.newLines !== undefined && object.newLines !== null) {
+      message.newLines = Number(object.newLines);
+    } else {
+      message.newLines = 0;
+    }
+    if (object.overlap !== undefined && object.overlap !== null) {
+      message.overlap = Number(object.overlap);
+    } else {
+      message.overlap = 0;
+    }
+    if (object.importance !== undefined && object.importance !== null) {
+      message.importance = Number(object.importance);
+    } else {
+      message.importance = 0;
+    }
+    return message;
+  },
+
+  toJSON(message: TestMetrics): unknown {
+    const obj: any = {};
+    message.test !== undefined && (obj.test = message.test);
+    message.linesCovered !== undefined &&
+      (obj.linesCovered = message.linesCovered);
+    message.newLines !== undefined && (obj.newLines = message.newLines);
+    message.overlap !== undefined && (obj.overlap = message.overlap);
+    message.importance !== undefined && (obj.importance = message.importance);
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<TestMetrics>): TestMetrics {
+    const message = { ...baseTestMetrics } as TestMetrics;
+    if (object.test !== undefined && object.test !== null) {
+      message.test = object.test;
+    } else {
+      message.test = "";
+    }
+    if (object.linesCovered !== undefined && object.linesCovered !== null) {
+      message.linesCovered = object.linesCovered;
+    } else {
+      message.linesCovered = 0;
+    }
+    if (object.newLines !== undefined && object.newLines !== null) {
+      message.newLines = object.newLines;
+    } else {
+      message.newLines = 0;
+    }
+    if (object.overlap !== undefined && object.overlap !== null) {
+      message.overlap = object.overlap;
+    } else {
+      message.overlap = 0;
+    }
+    if (object.importance !== undefined && object.importance !== null) {
+      message.importance = object.importance;
+    } else {
+      message.importance = 0;
+    }
+    return message;
+  },
+};
+
+const baseChapter: object = { title: "", tests: "" };
+
+export const Chapter = {
+  encode(
+    message: Chapter,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    for (const v of message.tests) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Chapter {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseChapter } as Chapter;
+    message.tests = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.title = reader.string();
+          break;
+        case 2:
+          message.tests.push(reader.string());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Chapter {
+    const message = { ...baseChapter } as Chapter;
+    message.tests = [];
+    if (object.title !== undefined && object.title !== null) {
+      message.title = String(object.title);
+    } else {
+      message.title = "";
+    }
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(String(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: Chapter): unknown {
+    const obj: any = {};
+    message.title !== undefined && (obj.title = message.title);
+    if (message.tests) {
+      obj.tests = message.tests.map((e) => e);
+    } else {
+      obj.tests = [];
+    }
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<Chapter>): Chapter {
+    const message = { ...baseChapter } as Chapter;
+    message.tests = [];
+    if (object.title !== undefined && object.title !== null) {
+      message.title = object.title;
+    } else {
+      message.title = "";
+    }
+    if (object.tests !== undefined && object.tests !== null) {
+      for (const e of object.tests) {
+        message.tests.push(e);
+      }
+    }
+    return message;
+  },
+};
+
+const baseTestRefinement: object = { test: "", refines: "" };
+
+export const TestRefinement = {
+  encode(
+    message: TestRefinement,
+    writer: _m0.Writer = _m0.Writer.create()
+  ): _m0.Writer {
+    if (message.test !== "") {
+      writer.uint32(10).string(message.test);
+    }
+    for (const v of message.refines) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TestRefinement {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = { ...baseTestRefinement } as TestRefinement;
+    message.refines = [];
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          message.test = reader.string();
+          break;
+        case 2:
+          message.refines.push(reader.string());
+          break;
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TestRefinement {
+    const message = { ...baseTestRefinement } as TestRefinement;
+    message.refines = [];
+    if (object.test !== undefined && object.test !== null) {
+      message.test = String(object.test);
+    } else {
+      message.test = "";
+    }
+    if (object.refines !== undefined && object.refines !== null) {
+      for (const e of object.refines) {
+        message.refines.push(String(e));
+      }
+    }
+    return message;
+  },
+
+  toJSON(message: TestRefinement): unknown {
+    const obj: any = {};
+    message.test !== undefined && (obj.test = message.test);
+    if (message.refines) {
+      obj.refines = message.refines.map((e) => e);
+    } else {
+      obj.refines = [];
+    }
     return obj;
   },
 
-  fromPartial(object: DeepPartial<StartJobRequest>): StartJobRequest {
-    const message = { ...baseStartJobRequest } as StartJobRequest;
-    message.tests = [];
-    if (object.tests !== undefined && object.tests !== null) {
-      for (const e of object.tests) {
-        message.tests.push(e);
-      }
-    }
-    if (object.pkg !== undefined && object.pkg !== null) {
-      message.pkg = object.pkg;
+  fromPartial(object: DeepPartial<TestRefinement>): TestRefinement {
+    const message = { ...baseTestRefinement } as TestRefinement;
+    message.refines = [];
+    if (object.test !== undefined && object.test !== null) {
+      message.test = object.test;
     } else {
-      message.pkg = "";
+      message.test = "";
     }
-    if (object.sort !== undefined && object.sort !== null) {
-      message.sort = object.sort;
-    } else {
-      message.sort = 0;
+    if (object.refines !== undefined && object.refines !== null) {
+      for (const e of object.refines) {
+        message.refines.push(e);
+      }
     }
     return message;
   },
 };
 
-const baseStartJobResponse: object = { id: "" };
+const baseTestResult: object = {
+  test: "",
+  outcome: 0,
+  output: "",
+  durationMs: 0,
+  subtests: "",
+};
 
-export const StartJobResponse = {
+export const TestResult = {
   encode(
-    message: StartJobResponse,
+    message: TestResult,
     writer: _m0.Writer = _m0.Writer.create()
   ): _m0.Writer {
-    if (message.id !== "") {
-      writer.uint32(10).string(message.id);
+    if (message.test !== "") {
+      writer.uint32(10).string(message.test);
+    }
+    if (message.outcome !== 0) {
+      writer.uint32(16).int32(message.outcome);
+    }
+    if (message.output !== "") {
+      writer.uint32(26).string(message.output);
+    }
+    if (message.durationMs !== 0) {
+      writer.uint32(32).int64(message.durationMs);
+    }
+    for (const v of message.subtests) {
+      writer.uint32(42).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): StartJobResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TestResult {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseStartJobResponse } as StartJobResponse;
+    const message = { ...baseTestResult } as TestResult;
+    message.subtests = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.id = reader.string();
+          message.test = reader.string();
+          break;
+        case 2:
+          message.outcome = reader.int32() as any;
+          break;
+        case 3:
+          message.output = reader.string();
+          break;
+        case 4:
+          message.durationMs = longToNumber(reader.int64() as Long);
+          break;
+        case 5:
+          message.subtests.push(reader.string());
           break;
         default:
           reader.skipType(tag & 7);
@@ -218,58 +3161,107 @@ export const StartJobResponse = {
     return message;
   },
 
-  fromJSON(object: any): StartJobResponse {
-    const message = { ...baseStartJobResponse } as StartJobResponse;
-    if (object.id !== undefined && object.id !== null) {
-      message.id = String(object.id);
+  fromJSON(object: any): TestResult {
+    const message = { ...baseTestResult } as TestResult;
+    message.subtests = [];
+    if (object.test !== undefined && object.test !== null) {
+      message.test = String(object.test);
     } else {
-      message.id = "";
+      message.test = "";
+    }
+    if (object.outcome !== undefined && object.outcome !== null) {
+      message.outcome = testOutcomeFromJSON(object.outcome);
+    } else {
+      message.outcome = 0;
+    }
+    if (object.output !== undefined && object.output !== null) {
+      message.output = String(object.output);
+    } else {
+      message.output = "";
+    }
+    if (object.durationMs !== undefined && object.durationMs !== null) {
+      message.durationMs = Number(object.durationMs);
+    } else {
+      message.durationMs = 0;
+    }
+    if (object.subtests !== undefined && object.subtests !== null) {
+      for (const e of object.subtests) {
+        message.subtests.push(String(e));
+      }
     }
     return message;
   },
 
-  toJSON(message: StartJobResponse): unknown {
+  toJSON(message: TestResult): unknown {
     const obj: any = {};
-    message.id !== undefined && (obj.id = message.id);
+    message.test !== undefined && (obj.test = message.test);
+    message.outcome !== undefined &&
+      (obj.outcome = testOutcomeToJSON(message.outcome));
+    message.output !== undefined && (obj.output = message.output);
+    message.durationMs !== undefined && (obj.durationMs = message.durationMs);
+    if (message.subtests) {
+      obj.subtests = message.subtests.map((e) => e);
+    } else {
+      obj.subtests = [];
+    }
     return obj;
   },
 
-  fromPartial(object: DeepPartial<StartJobResponse>): StartJobResponse {
-    const message = { ...baseStartJobResponse } as StartJobResponse;
-    if (object.id !== undefined && object.id !== null) {
-      message.id = object.id;
+  fromPartial(object: DeepPartial<TestResult>): TestResult {
+    const message = { ...baseTestResult } as TestResult;
+    message.subtests = [];
+    if (object.test !== undefined && object.test !== null) {
+      message.test = object.test;
     } else {
-      message.id = "";
+      message.test = "";
+    }
+    if (object.outcome !== undefined && object.outcome !== null) {
+      message.outcome = object.outcome;
+    } else {
+      message.outcome = 0;
+    }
+    if (object.output !== undefined && object.output !== null) {
+      message.output = object.output;
+    } else {
+      message.output = "";
+    }
+    if (object.durationMs !== undefined && object.durationMs !== null) {
+      message.durationMs = object.durationMs;
+    } else {
+      message.durationMs = 0;
+    }
+    if (object.subtests !== undefined && object.subtests !== null) {
+      for (const e of object.subtests) {
+        message.subtests.push(e);
+      }
     }
     return message;
   },
 };
 
-const baseCheckoutFilesRequest: object = {};
+const baseStepDiff: object = {};
 
-export const CheckoutFilesRequest = {
+export const StepDiff = {
   encode(
-    message: CheckoutFilesRequest,
+    message: StepDiff,
     writer: _m0.Writer = _m0.Writer.create()
   ): _m0.Writer {
-    if (message.files !== undefined) {
-      FileMap.encode(message.files, writer.uint32(10).fork()).ldelim();
+    for (const v of message.files) {
+      FileDiff.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(
-    input: _m0.Reader | Uint8Array,
-    length?: number
-  ): CheckoutFilesRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StepDiff {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseCheckoutFilesRequest } as CheckoutFilesRequest;
+    const message = { ...baseStepDiff } as StepDiff;
+    message.files = [];
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.files = FileMap.decode(reader, reader.uint32());
+          message.files.push(FileDiff.decode(reader, reader.uint32()));
           break;
         default:
           reader.skipType(tag & 7);
@@ -279,78 +3271,81 @@ export const CheckoutFilesRequest = {
     return message;
   },
 
-  fromJSON(object: any): CheckoutFilesRequest {
-    const message = { ...baseCheckoutFilesRequest } as CheckoutFilesRequest;
+  fromJSON(object: any): StepDiff {
+    const message = { ...baseStepDiff } as StepDiff;
+    message.files = [];
     if (object.files !== undefined && object.files !== null) {
-      message.files = FileMap.fromJSON(object.files);
-    } else {
-      message.files = undefined;
+      for (const e of object.files) {
+        message.files.push(FileDiff.fromJSON(e));
+      }
     }
     return message;
   },
 
-  toJSON(message: CheckoutFilesRequest): unknown {
+  toJSON(message: StepDiff): unknown {
     const obj: any = {};
-    message.files !== undefined &&
-      (obj.files = message.files ? FileMap.toJSON(message.files) : undefined);
+    if (message.files) {
+      obj.files = message.files.map((e) =>
+        e ? FileDiff.toJSON(e) : undefined
+      );
+    } else {
+      obj.files = [];
+    }
     return obj;
   },
 
-  fromPartial(object: DeepPartial<CheckoutFilesRequest>): CheckoutFilesRequest {
-    const message = { ...baseCheckoutFilesRequest } as CheckoutFilesRequest;
+  fromPartial(object: DeepPartial<StepDiff>): StepDiff {
+    const message = { ...baseStepDiff } as StepDiff;
+    message.files = [];
     if (object.files !== undefined && object.files !== null) {
-      message.files = FileMap.fromPartial(object.files);
-    } else {
-      message.files = undefined;
+      for (const e of object.files) {
+        message.files.push(FileDiff.fromPartial(e));
+      }
     }
     return message;
   },
 };
 
-const baseJobStatusResponse: object = {
-  complete: false,
-  details: "",
-  error: "",
-};
+const baseFileDiff: object = { name: "", added: 0, removed: 0, unified: "" };
 
-export const JobStatusResponse = {
+export const FileDiff = {
   encode(
-    message: JobStatusResponse,
+    message: FileDiff,
     writer: _m0.Writer = _m0.Writer.create()
   ): _m0.Writer {
-    if (message.complete === true) {
-      writer.uint32(8).bool(message.complete);
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.details !== "") {
-      writer.uint32(18).string(message.details);
+    if (message.added !== 0) {
+      writer.uint32(16).int32(message.added);
     }
-    if (message.error !== "") {
-      writer.uint32(26).string(message.error);
+    if (message.removed !== 0) {
+      writer.uint32(24).int32(message.removed);
     }
-    if (message.results !== undefined) {
-      JobResults.encode(message.results, writer.uint32(34).fork()).ldelim();
+    if (message.unified !== "") {
+      writer.uint32(34).string(message.unified);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): JobStatusResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FileDiff {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseJobStatusResponse } as JobStatusResponse;
+    const message = { ...baseFileDiff } as FileDiff;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.complete = reader.bool();
+          message.name = reader.string();
           break;
         case 2:
-          message.details = reader.string();
+          message.added = reader.int32();
           break;
         case 3:
-          message.error = reader.string();
+          message.removed = reader.int32();
           break;
         case 4:
-          message.results = JobResults.decode(reader, reader.uint32());
+          message.unified = reader.string();
           break;
         default:
           reader.skipType(tag & 7);
@@ -360,99 +3355,139 @@ export const JobStatusResponse = {
     return message;
   },
 
-  fromJSON(object: any): JobStatusResponse {
-    const message = { ...baseJobStatusResponse } as JobStatusResponse;
-    if (object.complete !== undefined && object.complete !== null) {
-      message.complete = Boolean(object.complete);
+  fromJSON(object: any): FileDiff {
+    const message = { ...baseFileDiff } as FileDiff;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = String(object.name);
     } else {
-      message.complete = false;
+      message.name = "";
     }
-    if (object.details !== undefined && object.details !== null) {
-      message.details = String(object.details);
+    if (object.added !== undefined && object.added !== null) {
+      message.added = Number(object.added);
     } else {
-      message.details = "";
+      message.added = 0;
     }
-    if (object.error !== undefined && object.error !== null) {
-      message.error = String(object.error);
+    if (object.removed !== undefined && object.removed !== null) {
+      message.removed = Number(object.removed);
     } else {
-      message.error = "";
+      message.removed = 0;
     }
-    if (object.results !== undefined && object.results !== null) {
-      message.results = JobResults.fromJSON(object.results);
+    if (object.unified !== undefined && object.unified !== null) {
+      message.unified = String(object.unified);
     } else {
-      message.results = undefined;
+      message.unified = "";
     }
     return message;
   },
 
-  toJSON(message: JobStatusResponse): unknown {
+  toJSON(message: FileDiff): unknown {
     const obj: any = {};
-    message.complete !== undefined && (obj.complete = message.complete);
-    message.details !== undefined && (obj.details = message.details);
-    message.error !== undefined && (obj.error = message.error);
-    message.results !== undefined &&
-      (obj.results = message.results
-        ? JobResults.toJSON(message.results)
-        : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.added !== undefined && (obj.added = message.added);
+    message.removed !== undefined && (obj.removed = message.removed);
+    message.unified !== undefined && (obj.unified = message.unified);
     return obj;
   },
 
-  fromPartial(object: DeepPartial<JobStatusResponse>): JobStatusResponse {
-    const message = { ...baseJobStatusResponse } as JobStatusResponse;
-    if (object.complete !== undefined && object.complete !== null) {
-      message.complete = object.complete;
+  fromPartial(object: DeepPartial<FileDiff>): FileDiff {
+    const message = { ...baseFileDiff } as FileDiff;
+    if (object.name !== undefined && object.name !== null) {
+      message.name = object.name;
     } else {
-      message.complete = false;
+      message.name = "";
     }
-    if (object.details !== undefined && object.details !== null) {
-      message.details = object.details;
+    if (object.added !== undefined && object.added !== null) {
+      message.added = object.added;
     } else {
-      message.details = "";
+      message.added = 0;
     }
-    if (object.error !== undefined && object.error !== null) {
-      message.error = object.error;
+    if (object.removed !== undefined && object.removed !== null) {
+      message.removed = object.removed;
     } else {
-      message.error = "";
+      message.removed = 0;
     }
-    if (object.results !== undefined && object.results !== null) {
-      message.results = JobResults.fromPartial(object.results);
+    if (object.unified !== undefined && object.unified !== null) {
+      message.unified = object.unified;
     } else {
-      message.results = undefined;
+      message.unified = "";
     }
     return message;
   },
 };
 
-const baseJobResults: object = { tests: "" };
+const baseJobEvent: object = {
+  type: 0,
+  message: "",
+  step: 0,
+  test: "",
+  kind: 0,
+};
 
-export const JobResults = {
+export const JobEvent = {
   encode(
-    message: JobResults,
+    message: JobEvent,
     writer: _m0.Writer = _m0.Writer.create()
   ): _m0.Writer {
-    for (const v of message.tests) {
-      writer.uint32(10).string(v!);
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
     }
-    for (const v of message.files) {
-      FileMap.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    if (message.step !== 0) {
+      writer.uint32(24).int32(message.step);
+    }
+    if (message.test !== "") {
+      writer.uint32(34).string(message.test);
+    }
+    if (message.diff !== undefined) {
+      StepDiff.encode(message.diff, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.status !== undefined) {
+      JobStatusResponse.encode(
+        message.status,
+        writer.uint32(50).fork()
+      ).ldelim();
+    }
+    if (message.progress !== undefined) {
+      JobProgress.encode(message.progress, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.kind !== 0) {
+      writer.uint32(64).int32(message.kind);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): JobResults {
+  decode(input: _m0.Reader | Uint8Array, length?: number): JobEvent {
     const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = { ...baseJobResults } as JobResults;
-    message.tests = [];
-    message.files = [];
+    const message = { ...baseJobEvent } as JobEvent;
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          message.tests.push(reader.string());
+          message.type = reader.int32() as any;
           break;
         case 2:
-          message.files.push(FileMap.decode(reader, reader.uint32()));
+          message.message = reader.string();
+          break;
+        case 3:
+          message.step = reader.int32();
+          break;
+        case 4:
+          message.test = reader.string();
+          break;
+        case 5:
+          message.diff = StepDiff.decode(reader, reader.uint32());
+          break;
+        case 6:
+          message.status = JobStatusResponse.decode(reader, reader.uint32());
+          break;
+        case 7:
+          message.progress = JobProgress.decode(reader, reader.uint32());
+          break;
+        case 8:
+          message.kind = reader.int32() as any;
           break;
         default:
           reader.skipType(tag & 7);
@@ -462,51 +3497,113 @@ export const JobResults = {
     return message;
   },
 
-  fromJSON(object: any): JobResults {
-    const message = { ...baseJobResults } as JobResults;
-    message.tests = [];
-    message.files = [];
-    if (object.tests !== undefined && object.tests !== null) {
-      for (const e of object.tests) {
-        message.tests.push(String(e));
-      }
+  fromJSON(object: any): JobEvent {
+    const message = { ...baseJobEvent } as JobEvent;
+    if (object.type !== undefined && object.type !== null) {
+      message.type = jobEvent_TypeFromJSON(object.type);
+    } else {
+      message.type = 0;
     }
-    if (object.files !== undefined && object.files !== null) {
-      for (const e of object.files) {
-        message.files.push(FileMap.fromJSON(e));
-      }
+    if (object.message !== undefined && object.message !== null) {
+      message.message = String(object.message);
+    } else {
+      message.message = "";
+    }
+    if (object.step !== undefined && object.step !== null) {
+      message.step = Number(object.step);
+    } else {
+      message.step = 0;
+    }
+    if (object.test !== undefined && object.test !== null) {
+      message.test = String(object.test);
+    } else {
+      message.test = "";
+    }
+    if (object.diff !== undefined && object.diff !== null) {
+      message.diff = StepDiff.fromJSON(object.diff);
+    } else {
+      message.diff = undefined;
+    }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = JobStatusResponse.fromJSON(object.status);
+    } else {
+      message.status = undefined;
+    }
+    if (object.progress !== undefined && object.progress !== null) {
+      message.progress = JobProgress.fromJSON(object.progress);
+    } else {
+      message.progress = undefined;
+    }
+    if (object.kind !== undefined && object.kind !== null) {
+      message.kind = testKindFromJSON(object.kind);
+    } else {
+      message.kind = 0;
     }
     return message;
   },
 
-  toJSON(message: JobResults): unknown {
+  toJSON(message: JobEvent): unknown {
     const obj: any = {};
-    if (message.tests) {
-      obj.tests = message.tests.map((e) => e);
+    message.type !== undefined &&
+      (obj.type = jobEvent_TypeToJSON(message.type));
+    message.message !== undefined && (obj.message = message.message);
+    message.step !== undefined && (obj.step = message.step);
+    message.test !== undefined && (obj.test = message.test);
+    message.diff !== undefined &&
+      (obj.diff = message.diff ? StepDiff.toJSON(message.diff) : undefined);
+    message.status !== undefined &&
+      (obj.status = message.status
+        ? JobStatusResponse.toJSON(message.status)
+        : undefined);
+    message.progress !== undefined &&
+      (obj.progress = message.progress
+        ? JobProgress.toJSON(message.progress)
+        : undefined);
+    message.kind !== undefined && (obj.kind = testKindToJSON(message.kind));
+    return obj;
+  },
+
+  fromPartial(object: DeepPartial<JobEvent>): JobEvent {
+    const message = { ...baseJobEvent } as JobEvent;
+    if (object.type !== undefined && object.type !== null) {
+      message.type = object.type;
     } else {
-      obj.tests = [];
+      message.type = 0;
     }
-    if (message.files) {
-      obj.files = message.files.map((e) => (e ? FileMap.toJSON(e) : undefined));
+    if (object.message !== undefined && object.message !== null) {
+      message.message = object.message;
     } else {
-      obj.files = [];
+      message.message = "";
     }
-    return obj;
-  },
-
-  fromPartial(object: DeepPartial<JobResults>): JobResults {
-    const message = { ...baseJobResults } as JobResults;
-    message.tests = [];
-    message.files = [];
-    if (object.tests !== undefined && object.tests !== null) {
-      for (const e of object.tests) {
-        message.tests.push(e);
-      }
+    if (object.step !== undefined && object.step !== null) {
+      message.step = object.step;
+    } else {
+      message.step = 0;
     }
-    if (object.files !== undefined && object.files !== null) {
-      for (const e of object.files) {
-        message.files.push(FileMap.fromPartial(e));
-      }
+    if (object.test !== undefined && object.test !== null) {
+      message.test = object.test;
+    } else {
+      message.test = "";
+    }
+    if (object.diff !== undefined && object.diff !== null) {
+      message.diff = StepDiff.fromPartial(object.diff);
+    } else {
+      message.diff = undefined;
+    }
+    if (object.status !== undefined && object.status !== null) {
+      message.status = JobStatusResponse.fromPartial(object.status);
+    } else {
+      message.status = undefined;
+    }
+    if (object.progress !== undefined && object.progress !== null) {
+      message.progress = JobProgress.fromPartial(object.progress);
+    } else {
+      message.progress = undefined;
+    }
+    if (object.kind !== undefined && object.kind !== null) {
+      message.kind = object.kind;
+    } else {
+      message.kind = 0;
     }
     return message;
   },
@@ -715,6 +3812,13 @@ export type DeepPartial<T> = T extends Builtin
   ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
@@ -0,0 +1,48 @@
+// Package gitcmd provides a wrapper for interacting with the git cmd line tool
+package gitcmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// Identity used for commits created by the tool, so committing doesn't depend
+// on the user's git configuration
+var commitEnv = []string{
+	"GIT_AUTHOR_NAME=commitlog",
+	"GIT_AUTHOR_EMAIL=commitlog@localhost",
+	"GIT_COMMITTER_NAME=commitlog",
+	"GIT_COMMITTER_EMAIL=commitlog@localhost",
+}
+
+func run(dir string, env []string, args ...string) error {
+	var stdErr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stderr = &stdErr
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("%s: %s", err, stdErr.String())
+	}
+	return nil
+}
+
+// Init creates an empty git repository in dir
+func Init(dir string) error {
+	return run(dir, nil, "init", "--quiet")
+}
+
+// CommitAll stages every change in the working tree of the repository in dir,
+// including deletions, and commits them with the given message. A commit is
+// created even if nothing changed.
+func CommitAll(dir, message string) error {
+	err := run(dir, nil, "add", "--all")
+	if err != nil {
+		return err
+	}
+
+	return run(dir, commitEnv, "commit", "--quiet", "--allow-empty", "--no-verify", "-m", message)
+}
@@ -1,16 +1,23 @@
 module commitlog
 
-go 1.15
+go 1.22.0
 
 require (
 	github.com/dave/dst v0.26.2
-	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/go-chi/chi/v5 v5.0.2
 	github.com/go-chi/cors v1.2.0
 	github.com/go-jira/jira v1.0.28 // indirect
 	github.com/google/uuid v1.2.0
+	golang.org/x/tools v0.26.0
+	google.golang.org/protobuf v1.26.0
+)
+
+require (
+	github.com/coryb/oreo v0.0.0-20180804211640-3e1b88fc08f1 // indirect
 	github.com/sergi/go-diff v1.2.0 // indirect
 	github.com/stretchr/testify v1.7.0 // indirect
-	golang.org/x/tools v0.1.0
-	google.golang.org/protobuf v1.26.0
+	github.com/theckman/go-flock v0.4.0 // indirect
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
+	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
 )
@@ -0,0 +1,163 @@
+package gocmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"commitlog/api"
+
+	"golang.org/x/tools/cover"
+)
+
+// testBinary is a coverage instrumented test binary for a package, which is
+// built the first time it's needed
+type testBinary struct {
+	pkg  string
+	opts *api.TestOptions
+	// refs counts the jobs using the binary, and is guarded by the
+	// CoverageRunner's mu
+	refs int
+
+	// mu guards the fields below, and is held while building
+	mu    sync.Mutex
+	built bool
+	err   error
+	dir   string
+	path  string
+	// pkgDir is the directory the tests are run in, as go test would
+	pkgDir string
+}
+
+// build compiles the binary unless it has already been built. A build
+// abandoned because ctx is done is retried by the next call.
+func (b *testBinary) build(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.built {
+		return b.err
+	}
+
+	err := b.compile(ctx)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	b.built = true
+	b.err = err
+	return err
+}
+
+func (b *testBinary) compile(ctx context.Context) error {
+	pkgDir, err := packageDir(b.pkg)
+	if err != nil {
+		return err
+	}
+
+	dir, err := ioutil.TempDir("", "commitlog-test")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, "pkg.test")
+
+	targetName := b.pkg
+	if strings.HasPrefix(b.pkg, "/") {
+		targetName = "."
+	}
+	args := append([]string{"test", "-c", "-cover", "-o", path}, buildFlags(b.opts)...)
+	cmd := exec.Command("go", append(args, targetName)...)
+	setPackageEnv(cmd, b.pkg)
+	setOptionsEnv(cmd, b.opts)
+	out, err := runCombinedOutput(ctx, cmd)
+	if err != nil {
+		os.RemoveAll(dir)
+		if ctx.Err() == nil && errors.As(err, new(*exec.ExitError)) {
+			return &buildError{output: out}
+		}
+		return fmt.Errorf("failed to build tests: %s: %s", err, out)
+	}
+	// go test -c doesn't write a binary for packages without tests
+	if _, err := os.Stat(path); err != nil {
+		os.RemoveAll(dir)
+		return fmt.Errorf("no tests to build in %s", b.pkg)
+	}
+
+	b.dir = dir
+	b.path = path
+	b.pkgDir = pkgDir
+	return nil
+}
+
+// buildError is returned when the tests of a package don't compile
+type buildError struct {
+	output []byte
+}
+
+func (e *buildError) Error() string {
+	return fmt.Sprintf("failed to build tests: %s", e.output)
+}
+
+// coverage runs a single test with the binary, writing its coverage profile
+// to coverFilename, and returns the parsed profiles and how the test ended,
+// as TestCover does
+func (b *testBinary) coverage(ctx context.Context, test, coverFilename string) ([]*cover.Profile, *api.TestResult, error) {
+	defer os.Remove(coverFilename)
+
+	err := b.build(ctx)
+	var buildErr *buildError
+	if errors.As(err, &buildErr) {
+		return nil, &api.TestResult{
+			Test:    test,
+			Outcome: api.TestOutcome_BUILD_ERROR,
+			Output:  string(buildErr.output),
+		}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// test2json turns the verbose output of the binary into the events go
+	// test -json reports
+	args := []string{"tool", "test2json", "-t", b.path, "-test.v", "-test.run", runPattern(test), "-test.coverprofile=" + coverFilename}
+	args = append(args, testFlags(b.opts, "-test.")...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = b.pkgDir
+	setOptionsEnv(cmd, b.opts)
+	out, err := runCombinedOutput(ctx, cmd)
+
+	result, err := testResult(test, err, out)
+	if err != nil {
+		return nil, nil, err
+	}
+	profiles, err := parseProfiles(coverFilename, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return profiles, result, nil
+}
+
+// remove deletes the binary
+func (b *testBinary) remove() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.dir != "" {
+		os.RemoveAll(b.dir)
+	}
+	b.built = true
+	b.err = fmt.Errorf("test binary for %s was removed", b.pkg)
+}
+
+// runCombinedOutput runs cmd as run does, returning its combined output
+func runCombinedOutput(ctx context.Context, cmd *exec.Cmd) ([]byte, error) {
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	err := run(ctx, cmd)
+	return out.Bytes(), err
+}
@@ -0,0 +1,160 @@
+package gocmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"commitlog/api"
+
+	"golang.org/x/tools/cover"
+)
+
+// copyTestdata copies the package in testdata/name to a new directory, so
+// that tests can change its files, and returns the directory
+func copyTestdata(t *testing.T, name string) string {
+	dir, err := ioutil.TempDir("", name)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	for _, f := range files {
+		content, err := ioutil.ReadFile(filepath.Join("testdata", name, f.Name()))
+		if err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, f.Name()), content, 0644); err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+	}
+	return dir
+}
+
+// coveredLines returns which of lines are in a block the profiles cover
+func coveredLines(profiles []*cover.Profile, lines []int) []int {
+	var covered []int
+next:
+	for _, line := range lines {
+		for _, p := range profiles {
+			for _, b := range p.Blocks {
+				if b.Count > 0 && b.StartLine <= line && line <= b.EndLine {
+					covered = append(covered, line)
+					continue next
+				}
+			}
+		}
+	}
+	return covered
+}
+
+func TestCoverageRunner_CompileTests(t *testing.T) {
+	pkg := copyTestdata(t, "binary")
+	defer os.RemoveAll(pkg)
+
+	tests := []struct {
+		test     string
+		outcome  api.TestOutcome
+		subtests []string
+		// covered are the statements of calc.go the test covers
+		covered []int
+	}{
+		{
+			test:    "TestAdd",
+			outcome: api.TestOutcome_PASS,
+			covered: []int{5},
+		},
+		{
+			test:     "TestAbs",
+			outcome:  api.TestOutcome_PASS,
+			subtests: []string{"TestAbs/negative", "TestAbs/positive"},
+			covered:  []int{10, 11, 13},
+		},
+		{
+			test:    "TestAbs/negative",
+			outcome: api.TestOutcome_PASS,
+			covered: []int{10, 11},
+		},
+		{
+			test:    "TestAbs/positive",
+			outcome: api.TestOutcome_PASS,
+			covered: []int{10, 13},
+		},
+		{
+			test:    "TestSkip",
+			outcome: api.TestOutcome_SKIP,
+		},
+		{
+			test:    "TestFail",
+			outcome: api.TestOutcome_FAIL,
+		},
+	}
+
+	// The lines of the statements in calc.go
+	statements := []int{5, 10, 11, 13}
+
+	runner := &CoverageRunner{}
+	release := runner.CompileTests(pkg, nil)
+
+	// The tests run at once, as a job runs them, and share one build
+	profiles := make([][]*cover.Profile, len(tests))
+	results := make([]*api.TestResult, len(tests))
+	errs := make([]error, len(tests))
+	var wg sync.WaitGroup
+	for i, test := range tests {
+		wg.Add(1)
+		go func(i int, test string) {
+			defer wg.Done()
+			profiles[i], results[i], errs[i] = runner.GetCoverage(context.Background(), pkg, test, nil)
+		}(i, test.test)
+	}
+	wg.Wait()
+
+	for i, test := range tests {
+		if errs[i] != nil {
+			t.Errorf("%s: unexpected error: %s", test.test, errs[i])
+			continue
+		}
+		if results[i].GetOutcome() != test.outcome {
+			t.Errorf("%s: expected outcome %s, got %s:\n%s", test.test, test.outcome, results[i].GetOutcome(), results[i].GetOutput())
+		}
+		if !reflect.DeepEqual(results[i].GetSubtests(), test.subtests) {
+			t.Errorf("%s: expected subtests %q, got %q", test.test, test.subtests, results[i].GetSubtests())
+		}
+		if covered := coveredLines(profiles[i], statements); !reflect.DeepEqual(covered, test.covered) {
+			t.Errorf("%s: expected lines %v to be covered, got %v", test.test, test.covered, covered)
+		}
+	}
+
+	// A rebuilt binary would run the failing TestAdd
+	failing := "package calc\n\nimport \"testing\"\n\nfunc TestAdd(t *testing.T) {\n\tt.Error(\"changed\")\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(pkg, "calc_test.go"), []byte(failing), 0644); err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	_, result, err := runner.GetCoverage(context.Background(), pkg, "TestAdd", nil)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if result.GetOutcome() != api.TestOutcome_PASS {
+		t.Errorf("expected the binary to be built once, got outcome %s:\n%s", result.GetOutcome(), result.GetOutput())
+	}
+
+	// Once released, the next job builds the changed tests
+	release()
+	release = runner.CompileTests(pkg, nil)
+	defer release()
+	_, result, err = runner.GetCoverage(context.Background(), pkg, "TestAdd", nil)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if result.GetOutcome() != api.TestOutcome_FAIL {
+		t.Errorf("expected a released binary to be rebuilt, got outcome %s:\n%s", result.GetOutcome(), result.GetOutput())
+	}
+}
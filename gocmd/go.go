@@ -3,14 +3,24 @@ package gocmd
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"golang.org/x/tools/cover"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+
+	"commitlog/api"
 )
 
 func List() ([]string, error) {
@@ -25,33 +35,317 @@ func List() ([]string, error) {
 	return strings.Split(stdOut.String(), "\n"), nil
 }
 
-func TestCover(pkg, test, coverFilename string) ([]*cover.Profile, error) {
+// CoverageRunner collects coverage profiles by running tests with go test.
+// The zero value is ready to use, and it is safe for concurrent use.
+type CoverageRunner struct {
+	mu sync.Mutex
+	// binaries holds the test binaries of the packages passed to
+	// CompileTests, by package and options
+	binaries map[string]*testBinary
+}
+
+// GetCoverage runs a single test in pkg with opts, which may be nil, and
+// returns the coverage profiles it produces along with how the test ended.
+// The test binary built for pkg and opts is used if CompileTests has been
+// called for them, otherwise go test builds the package for this test.
+func (r *CoverageRunner) GetCoverage(ctx context.Context, pkg string, test string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
+	f, err := ioutil.TempFile("", "")
+	if err != nil {
+		return nil, nil, err
+	}
+	f.Close()
+
+	r.mu.Lock()
+	binary := r.binaries[binaryKey(pkg, opts)]
+	r.mu.Unlock()
+	if binary != nil {
+		return binary.coverage(ctx, test, f.Name())
+	}
+	return TestCover(ctx, pkg, test, f.Name(), opts)
+}
+
+// CompileTests makes GetCoverage run the tests of pkg with opts using a
+// single coverage instrumented test binary, built the first time it's
+// needed, rather than running go test for every test. The binary is shared
+// until every caller has called the returned release function. Tests with
+// extra go test arguments always run with go test, since the arguments
+// can't be split between building and running the binary.
+func (r *CoverageRunner) CompileTests(pkg string, opts *api.TestOptions) (release func()) {
+	if len(opts.GetArgs()) > 0 {
+		return func() {}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := binaryKey(pkg, opts)
+	if r.binaries == nil {
+		r.binaries = map[string]*testBinary{}
+	}
+	binary, ok := r.binaries[key]
+	if !ok {
+		binary = &testBinary{pkg: pkg, opts: opts}
+		r.binaries[key] = binary
+	}
+	binary.refs++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			binary.refs--
+			if binary.refs == 0 {
+				delete(r.binaries, key)
+				binary.remove()
+			}
+		})
+	}
+}
+
+// SourceHash returns a hash of the files in the directories of pkg and the
+// packages matched by coverPkgs
+func (r *CoverageRunner) SourceHash(pkg string, coverPkgs []string) (string, error) {
+	return SourceHash(pkg, coverPkgs)
+}
+
+// SourceHash returns a hash of the files in the directory of pkg and of
+// every package matched by the coverPkgs patterns, which changes whenever
+// the source or tests of pkg or the source of a package it collects
+// coverage for change. Relative patterns are resolved from pkg, as go test
+// does.
+func SourceHash(pkg string, coverPkgs []string) (string, error) {
+	dir, err := packageDir(pkg)
+	if err != nil {
+		return "", err
+	}
+	dirs := []string{dir}
+	if len(coverPkgs) > 0 {
+		coverDirs, err := patternDirs(pkg, coverPkgs)
+		if err != nil {
+			return "", err
+		}
+		dirs = append(dirs, coverDirs...)
+	}
+
+	h := sha256.New()
+	seen := map[string]bool{}
+	for _, dir := range dirs {
+		if seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		fmt.Fprintf(h, "%s\n", dir)
+		err := hashDir(h, dir)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashDir writes the names and contents of the files in dir to w
+func hashDir(w io.Writer, dir string) error {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s %d\n", info.Name(), len(content))
+		w.Write(content)
+	}
+	return nil
+}
+
+// patternDirs returns the directories of the packages matched by patterns,
+// listed the way go test lists them when testing pkg
+func patternDirs(pkg string, patterns []string) ([]string, error) {
+	var stdOut, stdErr bytes.Buffer
+	args := append([]string{"list", "-f", "{{.Dir}}"}, patterns...)
+	cmd := exec.Command("go", args...)
+	setPackageEnv(cmd, pkg)
+	cmd.Stdout = &stdOut
+	cmd.Stderr = &stdErr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", err, stdErr.String())
+	}
+	var dirs []string
+	for _, dir := range strings.Split(stdOut.String(), "\n") {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs, nil
+}
+
+// packageDir returns the directory containing the source of pkg
+func packageDir(pkg string) (string, error) {
+	if strings.HasPrefix(pkg, "/") {
+		return pkg, nil
+	}
+
+	var stdOut, stdErr bytes.Buffer
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", pkg)
+	cmd.Stdout = &stdOut
+	cmd.Stderr = &stdErr
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", err, stdErr.String())
+	}
+	return strings.TrimSpace(stdOut.String()), nil
+}
+
+// TestCover runs a single test in pkg with opts, which may be nil, writing its
+// coverage profile to coverFilename, and returns the parsed profiles and how
+// the test ended. Tests that fail or time out return whatever coverage they
+// collected, and an error is only returned if the test couldn't be run. If
+// ctx is done before the test finishes, go test and the test binary are
+// killed.
+func TestCover(ctx context.Context, pkg, test, coverFilename string, opts *api.TestOptions) ([]*cover.Profile, *api.TestResult, error) {
 	targetName := pkg
 	if strings.HasPrefix(pkg, "/") {
 		targetName = "."
 	}
-	cmd := exec.Command("go", "test", targetName, "-run", "^"+test+"$", "--coverprofile="+coverFilename)
-	existingEnv := os.Environ()
+	args := []string{"test", targetName, "-json", "-run", runPattern(test), "--coverprofile=" + coverFilename}
+	args = append(args, buildFlags(opts)...)
+	args = append(args, testFlags(opts, "-")...)
+	args = append(args, opts.GetArgs()...)
+	cmd := exec.Command("go", args...)
+	setPackageEnv(cmd, pkg)
+	setOptionsEnv(cmd, opts)
+	out, err := runCombinedOutput(ctx, cmd)
+	defer os.Remove(coverFilename)
 
-	if strings.HasPrefix(pkg, "/") {
-		_, modName := modInfo(pkg)
-		if modName == "" {
-			cmd.Env = append(existingEnv, []string{"GO111MODULE=off"}...)
-		}
-		cmd.Dir = pkg
+	result, err := testResult(test, err, out)
+	if err != nil {
+		return nil, nil, err
 	}
-	err := cmd.Run()
+	profiles, err := parseProfiles(coverFilename, result)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return profiles, result, nil
+}
 
+// parseProfiles parses the coverage profile written by a test that ended as
+// described by result. Tests that didn't pass may not have written one, in
+// which case there's no coverage.
+func parseProfiles(coverFilename string, result *api.TestResult) ([]*cover.Profile, error) {
 	profiles, err := cover.ParseProfiles(coverFilename)
+	if err != nil && result.Outcome != api.TestOutcome_PASS {
+		return nil, nil
+	}
+	return profiles, err
+}
+
+// buildFlags returns the go test flags for opts that change how the tests
+// are built
+func buildFlags(opts *api.TestOptions) []string {
+	var flags []string
+	if len(opts.GetCoverPkgs()) > 0 {
+		flags = append(flags, "-coverpkg="+strings.Join(opts.GetCoverPkgs(), ","))
+	}
+	if len(opts.GetTags()) > 0 {
+		flags = append(flags, "-tags="+strings.Join(opts.GetTags(), ","))
+	}
+	if opts.GetCoverMode() != "" {
+		flags = append(flags, "-covermode="+opts.GetCoverMode())
+	}
+	return flags
+}
+
+// testFlags returns the flags for opts that change how the tests run. Each
+// flag starts with prefix, which is "-" for go test and "-test." for test
+// binaries.
+func testFlags(opts *api.TestOptions, prefix string) []string {
+	var flags []string
+	if opts.GetTimeout() != "" {
+		flags = append(flags, prefix+"timeout="+opts.GetTimeout())
+	}
+	if opts.GetShort() {
+		flags = append(flags, prefix+"short")
+	}
+	if opts.GetCount() > 0 {
+		flags = append(flags, prefix+"count="+strconv.Itoa(int(opts.GetCount())))
+	}
+	return flags
+}
+
+// setOptionsEnv adds the environment variables of opts to the environment
+// cmd runs with
+func setOptionsEnv(cmd *exec.Cmd, opts *api.TestOptions) {
+	env := opts.GetEnv()
+	if len(env) == 0 {
+		return
+	}
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	var keys []string
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cmd.Env = append(cmd.Env, k+"="+env[k])
+	}
+}
+
+// binaryKey identifies the test binary of pkg built with opts
+func binaryKey(pkg string, opts *api.TestOptions) string {
+	js, _ := json.Marshal(opts)
+	return pkg + "\x00" + string(js)
+}
+
+// run runs cmd, killing it and any processes it started if ctx is done
+// before it exits
+func run(ctx context.Context, cmd *exec.Cmd) error {
+	setProcessGroup(cmd)
+	err := cmd.Start()
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			killProcessGroup(cmd)
+		case <-exited:
+		}
+	}()
+
+	err = cmd.Wait()
+	close(exited)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// setPackageEnv runs cmd in the directory of pkg if it's an absolute path,
+// with modules disabled if the directory isn't part of a module
+func setPackageEnv(cmd *exec.Cmd, pkg string) {
+	if !strings.HasPrefix(pkg, "/") {
+		return
 	}
-	os.Remove(coverFilename)
 
-	return profiles, nil
+	_, modName := modInfo(pkg)
+	if modName == "" {
+		cmd.Env = append(os.Environ(), "GO111MODULE=off")
+	}
+	cmd.Dir = pkg
 }
 
 // modulePath returns the module path from the gomod file text.
@@ -121,39 +415,134 @@ func modInfo(dir string) (modDir string, modName string) {
 	}
 }
 
-func TestList(pkg string) ([]string, error) {
+// TestList returns the tests, examples and fuzz tests of pkg. Tests with subtests are replaced by their
+// subtests, named as go test reports them, like TestTable/case_one, so that
+// their coverage can be collected separately. Finding subtests requires
+// running the tests of the package, which are built and run with opts,
+// including its extra go test arguments.
+func TestList(pkg string, opts *api.TestOptions) ([]string, error) {
+	tests, err := topLevelTestList(pkg, opts)
+	if err != nil || len(tests) == 0 {
+		return tests, err
+	}
+
+	return expandSubtests(pkg, tests, opts)
+}
+
+// topLevelTestList returns the top level tests of pkg, without running them
+func topLevelTestList(pkg string, opts *api.TestOptions) ([]string, error) {
 	var stdOut, stdErr bytes.Buffer
 	targetName := pkg
 	if strings.HasPrefix(pkg, "/") {
 		targetName = "."
 	}
 
-	cmd := exec.Command("go", "test", targetName, "-list", ".*")
+	args := append([]string{"test", targetName, "-json", "-list", ".*"}, buildFlags(opts)...)
+	args = append(args, testFlags(opts, "-")...)
+	args = append(args, opts.GetArgs()...)
+	cmd := exec.Command("go", args...)
+	setPackageEnv(cmd, pkg)
+	setOptionsEnv(cmd, opts)
+	cmd.Stdout = &stdOut
+	cmd.Stderr = &stdErr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s%s", err, stdOut.String(), stdErr.String())
+	}
 
-	existingEnv := os.Environ()
+	var output []string
+	for _, e := range parseTestEvents(stdOut.Bytes()) {
+		if e.Action == "output" && e.Test == "" {
+			output = append(output, strings.TrimSuffix(e.Output, "\n"))
+		}
+	}
+	return filterToTests(output), nil
+}
 
+// expandSubtests runs tests and replaces each test that has subtests with its
+// innermost subtests, in the order they ran
+func expandSubtests(pkg string, tests []string, opts *api.TestOptions) ([]string, error) {
+	var stdOut, stdErr bytes.Buffer
+	targetName := pkg
 	if strings.HasPrefix(pkg, "/") {
-		_, modName := modInfo(pkg)
-		if modName == "" {
-			cmd.Env = append(existingEnv, []string{"GO111MODULE=off"}...)
-		}
-		cmd.Dir = pkg
+		targetName = "."
+	}
+
+	quoted := make([]string, len(tests))
+	for i, test := range tests {
+		quoted[i] = regexp.QuoteMeta(test)
 	}
+	args := []string{"test", targetName, "-json", "-run", "^(" + strings.Join(quoted, "|") + ")$"}
+	args = append(args, buildFlags(opts)...)
+	args = append(args, testFlags(opts, "-")...)
+	args = append(args, opts.GetArgs()...)
+	cmd := exec.Command("go", args...)
+	setPackageEnv(cmd, pkg)
+	setOptionsEnv(cmd, opts)
 	cmd.Stdout = &stdOut
 	cmd.Stderr = &stdErr
-	err := cmd.Run()
-	if err != nil {
-		return nil, fmt.Errorf("%s: %s", err, stdErr.String())
+	// Failing tests still report the subtests they ran
+	runErr := cmd.Run()
+
+	var ran []string
+	for _, e := range parseTestEvents(stdOut.Bytes()) {
+		if e.Action == "run" && e.Test != "" {
+			ran = append(ran, e.Test)
+		}
+	}
+	if runErr != nil && len(ran) == 0 {
+		return nil, fmt.Errorf("%s: %s", runErr, stdErr.String())
 	}
 
-	output := strings.Split(stdOut.String(), "\n")
-	return filterToTests(output), nil
+	return innermostSubtests(tests, ran), nil
+}
+
+// innermostSubtests replaces each test with the subtests of it in ran that
+// have no subtests of their own. Tests without subtests are kept.
+func innermostSubtests(tests, ran []string) []string {
+	hasSubtests := map[string]bool{}
+	for _, name := range ran {
+		for i := strings.LastIndex(name, "/"); i >= 0; i = strings.LastIndex(name[:i], "/") {
+			hasSubtests[name[:i]] = true
+		}
+	}
+
+	var out []string
+	for _, test := range tests {
+		if !hasSubtests[test] {
+			out = append(out, test)
+			continue
+		}
+		for _, name := range ran {
+			if strings.HasPrefix(name, test+"/") && !hasSubtests[name] {
+				out = append(out, name)
+			}
+		}
+	}
+	return out
+}
+
+// runPattern returns a -run pattern matching only test, which may be a
+// subtest
+func runPattern(test string) string {
+	elems := strings.Split(test, "/")
+	for i, elem := range elems {
+		elems[i] = "^" + regexp.QuoteMeta(elem) + "$"
+	}
+	return strings.Join(elems, "/")
+}
+
+// isTestName reports whether name is a function that can be run to collect
+// coverage, a test, example or fuzz test. Fuzz tests only run their seed
+// corpus.
+func isTestName(name string) bool {
+	return strings.HasPrefix(name, "Test") || strings.HasPrefix(name, "Example") || strings.HasPrefix(name, "Fuzz")
 }
 
 func filterToTests(ss []string) []string {
 	var out []string
 	for _, s := range ss {
-		if s != "" && len(strings.Fields(s)) == 1 && strings.HasPrefix(s, "Test") {
+		if s != "" && len(strings.Fields(s)) == 1 && isTestName(s) {
 			out = append(out, s)
 		}
 	}
@@ -0,0 +1,413 @@
+package gocmd
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"commitlog/api"
+)
+
+func TestRunPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		test     string
+		expected string
+	}{
+		{
+			name:     "top level test",
+			test:     "TestA",
+			expected: "^TestA$",
+		},
+		{
+			name:     "subtest",
+			test:     "TestTable/case_one",
+			expected: "^TestTable$/^case_one$",
+		},
+		{
+			name:     "nested subtest",
+			test:     "TestTable/outer/inner",
+			expected: "^TestTable$/^outer$/^inner$",
+		},
+		{
+			name:     "regex metacharacters",
+			test:     "TestRegex/a.b*(c)+[d]|e?",
+			expected: `^TestRegex$/^a\.b\*\(c\)\+\[d\]\|e\?$`,
+		},
+		{
+			// go test splits both the -run pattern and the test name on "/",
+			// so a subtest named "dir/file.go" is matched one element at a time.
+			name:     "slash in subtest name",
+			test:     "TestPath/dir/file.go",
+			expected: `^TestPath$/^dir$/^file\.go$`,
+		},
+	}
+
+	for _, test := range tests {
+		actual := runPattern(test.test)
+		if actual != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestInnermostSubtests(t *testing.T) {
+	tests := []struct {
+		name     string
+		tests    []string
+		ran      []string
+		expected []string
+	}{
+		{
+			name:     "no subtests",
+			tests:    []string{"TestA", "TestB"},
+			ran:      []string{"TestA", "TestB"},
+			expected: []string{"TestA", "TestB"},
+		},
+		{
+			name:     "one level",
+			tests:    []string{"TestA", "TestB"},
+			ran:      []string{"TestA", "TestA/one", "TestA/two", "TestB"},
+			expected: []string{"TestA/one", "TestA/two", "TestB"},
+		},
+		{
+			name:  "nested subtests",
+			tests: []string{"TestA"},
+			ran: []string{
+				"TestA",
+				"TestA/outer",
+				"TestA/outer/inner_one",
+				"TestA/outer/inner_two",
+				"TestA/leaf",
+			},
+			expected: []string{"TestA/outer/inner_one", "TestA/outer/inner_two", "TestA/leaf"},
+		},
+		{
+			name:     "test name is a prefix of another",
+			tests:    []string{"TestA", "TestAB"},
+			ran:      []string{"TestA", "TestAB", "TestAB/one"},
+			expected: []string{"TestA", "TestAB/one"},
+		},
+		{
+			name:     "regex metacharacters",
+			tests:    []string{"TestRegex"},
+			ran:      []string{"TestRegex", "TestRegex/a.b*(c)", "TestRegex/a.b"},
+			expected: []string{"TestRegex/a.b*(c)", "TestRegex/a.b"},
+		},
+		{
+			name:     "slash in subtest name",
+			tests:    []string{"TestPath"},
+			ran:      []string{"TestPath", "TestPath/dir/file.go"},
+			expected: []string{"TestPath/dir/file.go"},
+		},
+		{
+			name:     "test that did not run",
+			tests:    []string{"TestA", "TestSkipped"},
+			ran:      []string{"TestA", "TestA/one"},
+			expected: []string{"TestA/one", "TestSkipped"},
+		},
+	}
+
+	for _, test := range tests {
+		actual := innermostSubtests(test.tests, test.ran)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestSourceHash_CoverPkgs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sourcehash")
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+	}
+	write("go.mod", "module example.com/m\n")
+	write("a/a.go", "package a\n")
+	write("b/b.go", "package b\n")
+	write("c/c.go", "package c\n")
+
+	pkg := filepath.Join(dir, "a")
+	coverPkgs := []string{"example.com/m/b"}
+	hash := func() string {
+		h, err := SourceHash(pkg, coverPkgs)
+		if err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+		return h
+	}
+
+	before := hash()
+	write("c/c.go", "package c\n\nfunc C() {}\n")
+	if after := hash(); after != before {
+		t.Errorf("expected a change to an uncovered package to keep the hash")
+	}
+
+	write("b/b.go", "package b\n\nfunc B() {}\n")
+	changed := hash()
+	if changed == before {
+		t.Errorf("expected a change to a covered package to change the hash")
+	}
+
+	coverPkgs = []string{"../..."}
+	all := hash()
+	write("c/c.go", "package c\n")
+	if hash() == all {
+		t.Errorf("expected a relative pattern to be resolved from the package")
+	}
+}
+
+func TestBuildFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *api.TestOptions
+		expected []string
+	}{
+		{
+			name: "nil options",
+		},
+		{
+			name: "test flags only",
+			opts: &api.TestOptions{Timeout: "30s", Short: true, Count: 2, Args: []string{"-v"}},
+		},
+		{
+			name: "all build flags",
+			opts: &api.TestOptions{
+				CoverPkgs: []string{"./...", "example.com/m/b"},
+				Tags:      []string{"integration", "extra"},
+				CoverMode: "atomic",
+			},
+			expected: []string{"-coverpkg=./...,example.com/m/b", "-tags=integration,extra", "-covermode=atomic"},
+		},
+	}
+
+	for _, test := range tests {
+		actual := buildFlags(test.opts)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestTestFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *api.TestOptions
+		prefix   string
+		expected []string
+	}{
+		{
+			name:   "nil options",
+			prefix: "-",
+		},
+		{
+			name:   "build flags only",
+			opts:   &api.TestOptions{Tags: []string{"extra"}, CoverMode: "count", Args: []string{"-v"}},
+			prefix: "-",
+		},
+		{
+			name:     "go test",
+			opts:     &api.TestOptions{Timeout: "30s", Short: true, Count: 2},
+			prefix:   "-",
+			expected: []string{"-timeout=30s", "-short", "-count=2"},
+		},
+		{
+			name:     "test binary",
+			opts:     &api.TestOptions{Timeout: "1m", Short: true, Count: 3},
+			prefix:   "-test.",
+			expected: []string{"-test.timeout=1m", "-test.short", "-test.count=3"},
+		},
+	}
+
+	for _, test := range tests {
+		actual := testFlags(test.opts, test.prefix)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestSetOptionsEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      []string
+		opts     *api.TestOptions
+		expected []string
+	}{
+		{
+			name: "nil options",
+		},
+		{
+			name:     "no options env keeps cmd env",
+			env:      []string{"A=1"},
+			opts:     &api.TestOptions{},
+			expected: []string{"A=1"},
+		},
+		{
+			name:     "appended in key order",
+			env:      []string{"A=1"},
+			opts:     &api.TestOptions{Env: map[string]string{"C": "3", "B": "2"}},
+			expected: []string{"A=1", "B=2", "C=3"},
+		},
+		{
+			name:     "overrides earlier values",
+			env:      []string{"A=1"},
+			opts:     &api.TestOptions{Env: map[string]string{"A": "2"}},
+			expected: []string{"A=1", "A=2"},
+		},
+	}
+
+	for _, test := range tests {
+		cmd := exec.Command("go")
+		cmd.Env = test.env
+		setOptionsEnv(cmd, test.opts)
+		if !reflect.DeepEqual(cmd.Env, test.expected) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, cmd.Env)
+		}
+	}
+
+	cmd := exec.Command("go")
+	setOptionsEnv(cmd, &api.TestOptions{Env: map[string]string{"B": "2", "A": "1"}})
+	expected := append(os.Environ(), "A=1", "B=2")
+	if !reflect.DeepEqual(cmd.Env, expected) {
+		t.Errorf("expected the options env after the process env, got %q", cmd.Env)
+	}
+}
+
+func TestTestList_Args(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testlist")
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"go.mod":    "module example.com/m\n",
+		"a_test.go": "package m\n\nimport \"testing\"\n\nfunc TestA(t *testing.T) {}\n",
+		"extra_test.go": "//go:build extra\n\npackage m\n\nimport \"testing\"\n\n" +
+			"func TestExtra(t *testing.T) {\n\tt.Run(\"sub\", func(t *testing.T) {})\n}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+	}
+
+	tests, err := TestList(dir, &api.TestOptions{Args: []string{"-tags=extra"}})
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	expected := []string{"TestA", "TestExtra/sub"}
+	if !reflect.DeepEqual(tests, expected) {
+		t.Errorf("expected %q, got %q", expected, tests)
+	}
+}
+
+func TestIsTestName(t *testing.T) {
+	tests := map[string]bool{
+		"TestA":             true,
+		"Test":              true,
+		"ExampleFormat":     true,
+		"Example_suffix":    true,
+		"FuzzParse":         true,
+		"FuzzParse/seed#0":  true,
+		"BenchmarkA":        false,
+		"helper":            false,
+		"ok":                false,
+		"PASS":              false,
+		"testLowercaseTest": false,
+	}
+
+	for name, expected := range tests {
+		if actual := isTestName(name); actual != expected {
+			t.Errorf("%s: expected %t, got %t", name, expected, actual)
+		}
+	}
+}
+
+func TestFilterToTests(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    []string
+		expected []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:     "tests, examples and fuzz tests",
+			lines:    []string{"TestA", "ExampleFormat", "FuzzParse", "ok  \texample.com/m\t0.002s", ""},
+			expected: []string{"TestA", "ExampleFormat", "FuzzParse"},
+		},
+		{
+			name:     "benchmarks are left out",
+			lines:    []string{"TestA", "BenchmarkA", "ok  \texample.com/m\t0.002s"},
+			expected: []string{"TestA"},
+		},
+		{
+			name:  "no test files",
+			lines: []string{"?   \texample.com/m\t[no test files]"},
+		},
+		{
+			name:     "output of an init function",
+			lines:    []string{"Testing mode on", "TestA"},
+			expected: []string{"TestA"},
+		},
+	}
+
+	for _, test := range tests {
+		actual := filterToTests(test.lines)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestTestList_Kinds(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testlist")
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"go.mod":    "module example.com/m\n",
+		"a.go":      "package m\n\nfunc A() string { return \"a\" }\n",
+		"a_test.go": "package m\n\nimport \"testing\"\n\nfunc TestA(t *testing.T) {}\n",
+		// Examples without an output comment are compiled but never run
+		"example_test.go": "package m\n\nimport \"fmt\"\n\n" +
+			"func ExampleA() {\n\tfmt.Println(A())\n\t// Output: a\n}\n\n" +
+			"func ExampleA_noOutput() {\n\tfmt.Println(A())\n}\n",
+		// Without -fuzz, fuzz tests only run their seed corpus
+		"fuzz_test.go": "package m\n\nimport \"testing\"\n\n" +
+			"func FuzzA(f *testing.F) {\n\tf.Add(\"x\")\n\tf.Add(\"y\")\n\tf.Fuzz(func(t *testing.T, s string) { A() })\n}\n\n" +
+			"func FuzzNoSeeds(f *testing.F) {\n\tf.Fuzz(func(t *testing.T, s string) {})\n}\n",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal("unexpected error: ", err)
+		}
+	}
+
+	tests, err := TestList(dir, nil)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	expected := []string{"TestA", "FuzzA/seed#0", "FuzzA/seed#1", "FuzzNoSeeds", "ExampleA"}
+	if !reflect.DeepEqual(tests, expected) {
+		t.Errorf("expected %q, got %q", expected, tests)
+	}
+}
@@ -0,0 +1,12 @@
+package gocmd
+
+import (
+	"os/exec"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills cmd. Processes it started are not killed.
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.Process.Kill()
+}
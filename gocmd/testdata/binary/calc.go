@@ -0,0 +1,14 @@
+package calc
+
+// Add returns the sum of a and b
+func Add(a, b int) int {
+	return a + b
+}
+
+// Abs returns the absolute value of n
+func Abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,30 @@
+package calc
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if Add(1, 2) != 3 {
+		t.Error("wrong sum")
+	}
+}
+
+func TestAbs(t *testing.T) {
+	t.Run("negative", func(t *testing.T) {
+		if Abs(-1) != 1 {
+			t.Error("wrong absolute value")
+		}
+	})
+	t.Run("positive", func(t *testing.T) {
+		if Abs(1) != 1 {
+			t.Error("wrong absolute value")
+		}
+	})
+}
+
+func TestSkip(t *testing.T) {
+	t.Skip("not today")
+}
+
+func TestFail(t *testing.T) {
+	t.Error("broken")
+}
@@ -0,0 +1,137 @@
+package gocmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"os/exec"
+	"strings"
+	"time"
+
+	"commitlog/api"
+)
+
+// testEvent is a go test -json event, as described by go doc test2json
+type testEvent struct {
+	Action string
+	Test   string
+	// Elapsed is in seconds, and is set for pass, fail and skip events
+	Elapsed float64
+	Output  string
+}
+
+// parseTestEvents returns the go test -json events in out. Lines that aren't
+// events, like the output of go build before go 1.24, are returned as output
+// events of the package.
+func parseTestEvents(out []byte) []testEvent {
+	var events []testEvent
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var e testEvent
+		if len(line) == 0 || line[0] != '{' || json.Unmarshal(line, &e) != nil {
+			e = testEvent{Action: "output", Output: string(line) + "\n"}
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// testRun is what the go test -json events of running a single test say
+// about it
+type testRun struct {
+	// action is pass, fail or skip once the test has finished, and empty if
+	// it didn't finish
+	action  string
+	elapsed time.Duration
+	// output is what the test printed, and allOutput everything go test
+	// printed while running it
+	output    string
+	allOutput string
+	subtests  []string
+}
+
+func newTestRun(test string, events []testEvent) testRun {
+	var (
+		run               testRun
+		output, allOutput strings.Builder
+		packageElapsed    float64
+	)
+	for _, e := range events {
+		allOutput.WriteString(e.Output)
+		switch {
+		case e.Test == test:
+			output.WriteString(e.Output)
+			if e.Action == "pass" || e.Action == "fail" || e.Action == "skip" {
+				run.action = e.Action
+				run.elapsed = seconds(e.Elapsed)
+			}
+		case strings.HasPrefix(e.Test, test+"/"):
+			output.WriteString(e.Output)
+			if e.Action == "run" {
+				run.subtests = append(run.subtests, e.Test)
+			}
+		case e.Test == "" && e.Elapsed > 0:
+			packageElapsed = e.Elapsed
+		}
+	}
+
+	// A test that didn't finish ran for as long as its package did
+	if run.action == "" {
+		run.elapsed = seconds(packageElapsed)
+	}
+	run.output = output.String()
+	run.allOutput = allOutput.String()
+	return run
+}
+
+// seconds converts an elapsed time reported by go test, rounding away the
+// float error that would otherwise turn 1.007s into 1.006999999s
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Round(s * float64(time.Second)))
+}
+
+var (
+	timeoutOutput     = "panic: test timed out after"
+	buildErrorOutputs = []string{"[build failed]", "[setup failed]"}
+)
+
+// testResult works out how running test ended from the error returned by
+// running it and its go test -json output. Tests that didn't pass or skip
+// report everything go test printed, since the reason they stopped may not
+// be part of their own output. An error is returned if the test didn't run
+// to completion.
+func testResult(test string, err error, out []byte) (*api.TestResult, error) {
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, err
+	}
+
+	run := newTestRun(test, parseTestEvents(out))
+	result := &api.TestResult{
+		Test:       test,
+		Output:     run.allOutput,
+		DurationMs: run.elapsed.Milliseconds(),
+		Subtests:   run.subtests,
+	}
+	switch {
+	case err == nil && run.action == "skip":
+		result.Outcome = api.TestOutcome_SKIP
+		result.Output = run.output
+	case err == nil:
+		result.Outcome = api.TestOutcome_PASS
+		result.Output = run.output
+	case strings.Contains(run.allOutput, timeoutOutput):
+		result.Outcome = api.TestOutcome_TIMEOUT
+	case run.action == "fail":
+		result.Outcome = api.TestOutcome_FAIL
+	case strings.Contains(run.allOutput, buildErrorOutputs[0]) || strings.Contains(run.allOutput, buildErrorOutputs[1]):
+		result.Outcome = api.TestOutcome_BUILD_ERROR
+	default:
+		result.Outcome = api.TestOutcome_FAIL
+	}
+	return result, nil
+}
@@ -0,0 +1,242 @@
+package gocmd
+
+import (
+	"errors"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"commitlog/api"
+)
+
+// readRecording returns the output of go test -json recorded in
+// testdata/testjson/name.json
+func readRecording(t *testing.T, name string) []byte {
+	out, err := ioutil.ReadFile(filepath.Join("testdata", "testjson", name+".json"))
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	return out
+}
+
+func TestParseTestEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		out      string
+		expected []testEvent
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "events",
+			out: `{"Action":"run","Package":"p","Test":"TestA"}` + "\n" +
+				`{"Action":"pass","Package":"p","Test":"TestA","Elapsed":0.5}` + "\n",
+			expected: []testEvent{
+				{Action: "run", Test: "TestA"},
+				{Action: "pass", Test: "TestA", Elapsed: 0.5},
+			},
+		},
+		{
+			name: "non-JSON lines",
+			out:  "# p [p.test]\n./a_test.go:5:28: undefined: x\n\n" + `{"Action":"fail","Package":"p","Elapsed":0}`,
+			expected: []testEvent{
+				{Action: "output", Output: "# p [p.test]\n"},
+				{Action: "output", Output: "./a_test.go:5:28: undefined: x\n"},
+				{Action: "output", Output: "\n"},
+				{Action: "fail"},
+			},
+		},
+		{
+			name: "invalid JSON",
+			out:  "{not json}\n",
+			expected: []testEvent{
+				{Action: "output", Output: "{not json}\n"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		actual := parseTestEvents([]byte(test.out))
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestNewTestRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		recording string
+		test      string
+		action    string
+		elapsed   time.Duration
+		output    string
+		subtests  []string
+	}{
+		{
+			name:      "pass",
+			recording: "pass",
+			test:      "TestPass",
+			action:    "pass",
+			elapsed:   50 * time.Millisecond,
+			output:    "=== RUN   TestPass\npassing\n--- PASS: TestPass (0.05s)\n",
+		},
+		{
+			name:      "subtests",
+			recording: "subtests",
+			test:      "TestTable",
+			action:    "fail",
+			output: "=== RUN   TestTable\n=== RUN   TestTable/one\n--- PASS: TestTable/one (0.00s)\n" +
+				"=== RUN   TestTable/two\n    rec_test.go:15: bad two\n--- FAIL: TestTable/two (0.00s)\n" +
+				"--- FAIL: TestTable (0.00s)\n",
+			subtests: []string{"TestTable/one", "TestTable/two"},
+		},
+		{
+			name:      "subtest",
+			recording: "subtests",
+			test:      "TestTable/two",
+			action:    "fail",
+			output:    "=== RUN   TestTable/two\n    rec_test.go:15: bad two\n--- FAIL: TestTable/two (0.00s)\n",
+		},
+		{
+			name:      "unfinished test takes as long as its package",
+			recording: "timeout",
+			test:      "TestHang",
+			elapsed:   1007 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		run := newTestRun(test.test, parseTestEvents(readRecording(t, test.recording)))
+		if run.action != test.action {
+			t.Errorf("%s: expected action %q, got %q", test.name, test.action, run.action)
+		}
+		if run.elapsed != test.elapsed {
+			t.Errorf("%s: expected elapsed %s, got %s", test.name, test.elapsed, run.elapsed)
+		}
+		if test.output != "" && run.output != test.output {
+			t.Errorf("%s: expected output:\n%s\ngot:\n%s", test.name, test.output, run.output)
+		}
+		if !reflect.DeepEqual(run.subtests, test.subtests) {
+			t.Errorf("%s: expected subtests %q, got %q", test.name, test.subtests, run.subtests)
+		}
+	}
+}
+
+func TestTestResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		recording string
+		test      string
+		// failed is whether go test exited with an error
+		failed     bool
+		outcome    api.TestOutcome
+		durationMs int64
+		subtests   []string
+		// output is the whole output expected, or contains a part of it
+		output   string
+		contains string
+	}{
+		{
+			name:       "pass",
+			recording:  "pass",
+			test:       "TestPass",
+			outcome:    api.TestOutcome_PASS,
+			durationMs: 50,
+			output:     "=== RUN   TestPass\npassing\n--- PASS: TestPass (0.05s)\n",
+		},
+		{
+			name:      "skip",
+			recording: "skip",
+			test:      "TestSkip",
+			outcome:   api.TestOutcome_SKIP,
+			output:    "=== RUN   TestSkip\n    rec_test.go:10: not today\n--- SKIP: TestSkip (0.00s)\n",
+		},
+		{
+			name:      "fail",
+			recording: "fail",
+			test:      "TestFail",
+			failed:    true,
+			outcome:   api.TestOutcome_FAIL,
+			contains:  "    rec_test.go:11: broken\n--- FAIL: TestFail (0.00s)\nFAIL\nFAIL\texample.com/rec\t0.002s\n",
+		},
+		{
+			name:       "timeout",
+			recording:  "timeout",
+			test:       "TestHang",
+			failed:     true,
+			outcome:    api.TestOutcome_TIMEOUT,
+			durationMs: 1007,
+			contains:   "panic: test timed out after 1s\n",
+		},
+		{
+			name:      "build failure",
+			recording: "build_failed",
+			test:      "TestX",
+			failed:    true,
+			outcome:   api.TestOutcome_BUILD_ERROR,
+			contains:  "./x_test.go:5:28: undefined: undefined\n",
+		},
+		{
+			name:      "build failure printed as non-JSON lines",
+			recording: "build_failed_legacy",
+			test:      "TestX",
+			failed:    true,
+			outcome:   api.TestOutcome_BUILD_ERROR,
+			contains:  "./x_test.go:5:28: undefined: undefined\n",
+		},
+		{
+			name:      "failing subtest",
+			recording: "subtests",
+			test:      "TestTable",
+			failed:    true,
+			outcome:   api.TestOutcome_FAIL,
+			subtests:  []string{"TestTable/one", "TestTable/two"},
+			contains:  "    rec_test.go:15: bad two\n",
+		},
+	}
+
+	for _, test := range tests {
+		var err error
+		if test.failed {
+			err = &exec.ExitError{}
+		}
+		result, err := testResult(test.test, err, readRecording(t, test.recording))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+
+		if result.Test != test.test {
+			t.Errorf("%s: expected test %q, got %q", test.name, test.test, result.Test)
+		}
+		if result.Outcome != test.outcome {
+			t.Errorf("%s: expected outcome %s, got %s", test.name, test.outcome, result.Outcome)
+		}
+		if result.DurationMs != test.durationMs {
+			t.Errorf("%s: expected duration %dms, got %dms", test.name, test.durationMs, result.DurationMs)
+		}
+		if !reflect.DeepEqual(result.Subtests, test.subtests) {
+			t.Errorf("%s: expected subtests %q, got %q", test.name, test.subtests, result.Subtests)
+		}
+		if test.output != "" && result.Output != test.output {
+			t.Errorf("%s: expected output:\n%s\ngot:\n%s", test.name, test.output, result.Output)
+		}
+		if !strings.Contains(result.Output, test.contains) {
+			t.Errorf("%s: expected output containing:\n%s\ngot:\n%s", test.name, test.contains, result.Output)
+		}
+	}
+}
+
+func TestTestResult_NotRun(t *testing.T) {
+	runErr := errors.New("exec: \"go\": executable file not found in $PATH")
+	_, err := testResult("TestA", runErr, nil)
+	if err != runErr {
+		t.Errorf("expected the error running go test, got %v", err)
+	}
+}
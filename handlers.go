@@ -1,8 +1,13 @@
 package commitlog
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"commitlog/api"
 
@@ -16,7 +21,8 @@ type languageProvider interface {
 	// empty list
 	ListPackages() ([]string, error)
 	// ListTests returns identifiers for tests associated with the given package
-	ListTests(pkg string) ([]string, error)
+	// when built and run with opts, which may be nil
+	ListTests(pkg string, opts *api.TestOptions) ([]string, error)
 }
 
 type Handler struct {
@@ -31,6 +37,21 @@ type jobManager interface {
 	// JobStatus uses a job identifier to check the status of a job started with
 	// StartJob. It does not return an error in the case the provided id isn't found
 	JobStatus(string) (*jobCacheEntry, error)
+	// CancelJob uses a job identifier to stop a running job started with StartJob.
+	// It returns false if there is no running job with the identifier
+	CancelJob(string) bool
+	// JobEvents uses a job identifier to follow the progress of a job started
+	// with StartJob. The returned channel receives every event from the start
+	// of the job, and is closed after the job finishes or ctx is done. It
+	// returns false if there is no job with the identifier
+	JobEvents(ctx context.Context, id string) (<-chan jobEvent, bool)
+	// ListJobs returns the status of every job matching the filter, most
+	// recently created first
+	ListJobs(jobFilter) ([]jobListing, error)
+	// DeleteJob uses a job identifier to remove a finished job. It returns
+	// errJobNotFound if there is no job with the identifier, and errJobRunning
+	// if the job hasn't finished
+	DeleteJob(string) error
 }
 
 // Packages responds to requests to list the available packages
@@ -45,10 +66,24 @@ func (c *Handler) Packages(w http.ResponseWriter, r *http.Request) {
 }
 
 // Tests responds to requests to list the available tests for a package
-// the requested package is provided through the `pkg` query param
+// the requested package is provided through the `pkg` query param, or
+// posted with the options to build the tests with as a ListTestsRequest
 func (c *Handler) Tests(w http.ResponseWriter, r *http.Request) {
-	pkg := r.URL.Query().Get("pkg")
-	tests, err := c.LanguageInfo.ListTests(pkg)
+	req := api.ListTestsRequest{Pkg: r.URL.Query().Get("pkg")}
+	if r.Method == http.MethodPost {
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = ValidateTestOptions(req.GetOptions())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	tests, err := c.LanguageInfo.ListTests(req.GetPkg(), req.GetOptions())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -70,8 +105,79 @@ func (c *Handler) CheckoutFiles(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// ExportRepository writes the results of the job requested using the `id`
+// url parameter to a new git repository, in the directory given in the posted
+// request, with one commit per test
+func (c *Handler) ExportRepository(w http.ResponseWriter, r *http.Request) {
+	var req api.ExportRepositoryRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.GetDir() == "" {
+		http.Error(w, "dir is required", http.StatusBadRequest)
+		return
+	}
+
+	results, ok := c.completedJobResults(w, r)
+	if !ok {
+		return
+	}
+
+	err = WriteGitRepository(req.GetDir(), results)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// Patches responds with the results of the job requested using the `id`
+// url parameter as a series of patches in mbox format, suitable for git am
+func (c *Handler) Patches(w http.ResponseWriter, r *http.Request) {
+	results, ok := c.completedJobResults(w, r)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	err := WritePatchSeries(&buf, results, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/mbox")
+	w.Header().Set("Content-Disposition", `attachment; filename="commitlog.mbox"`)
+	w.Write(buf.Bytes())
+}
+
+// completedJobResults looks up the results of the job identified by the `id`
+// url parameter. If the job can't be found or isn't complete it responds with
+// an error and returns false.
+func (c *Handler) completedJobResults(w http.ResponseWriter, r *http.Request) (jobResult, bool) {
+	id := chi.URLParam(r, "id")
+	status, err := c.Jobs.JobStatus(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return jobResult{}, false
+	}
+	if status == nil {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return jobResult{}, false
+	}
+	if !status.Complete {
+		http.Error(w, "job is not complete", http.StatusConflict)
+		return jobResult{}, false
+	}
+
+	return status.Results, true
+}
+
 // JobStatus returns the status of the job requested using the `id`
-// query parameter
+// query parameter. If the `omitFiles` query parameter is true, the
+// full file contents for each step are left out of the results, leaving
+// only the per step diffs
 func (c *Handler) JobStatus(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	status, err := c.Jobs.JobStatus(id)
@@ -79,8 +185,167 @@ func (c *Handler) JobStatus(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if status == nil {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return
+	}
+
+	response := cacheEntryToAPIResponse(status)
+	if r.URL.Query().Get("omitFiles") == "true" {
+		response.Results.Files = nil
+	}
+
+	respondWithJSON(w, response)
+}
+
+// JobEvents streams the progress of the job requested using the `id` url
+// parameter as server-sent events. Every event since the start of the job is
+// sent, and the stream ends with a `done` event containing the final status
+func (c *Handler) JobEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	events, ok := c.Jobs.JobEvents(r.Context(), id)
+	if !ok {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	i := 0
+	for e := range events {
+		apiEvent := eventToAPIEvent(e)
+		js, err := json.Marshal(apiEvent)
+		if err != nil {
+			return
+		}
 
-	respondWithJSON(w, cacheEntryToAPIResponse(status))
+		fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", i, strings.ToLower(apiEvent.GetType().String()), js)
+		flusher.Flush()
+		i++
+	}
+}
+
+// ListJobs responds with a summary of every job matching the filter given
+// by the query parameters, most recently created first. Jobs can be filtered
+// by package with `pkg`, by state with a comma separated list of states in
+// `status`, and by creation time with RFC 3339 times in `since` and `until`
+func (c *Handler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	jobs, err := c.Jobs.ListJobs(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	respondWithJSON(w, listingsToAPIResponse(jobs))
+}
+
+// DeleteJob stops the job requested using the `id` url parameter if it's
+// running, and removes it if it has finished. A stopped job is kept, as
+// cancelled, until it's deleted again
+func (c *Handler) DeleteJob(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if c.Jobs.CancelJob(id) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	err := c.Jobs.DeleteJob(id)
+	switch err {
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errJobNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errJobRunning:
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// DeleteJobs removes every finished job matching the filter given by the
+// query parameters, as for ListJobs, and responds with a summary of the
+// deleted jobs. At least one filter is required so that every job isn't
+// deleted by accident
+func (c *Handler) DeleteJobs(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if filter.isEmpty() {
+		http.Error(w, "at least one of pkg, status, since or until is required", http.StatusBadRequest)
+		return
+	}
+
+	jobs, err := c.Jobs.ListJobs(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var deleted []jobListing
+	for _, job := range jobs {
+		err := c.Jobs.DeleteJob(job.ID)
+		// Jobs that are still running or were deleted by another request
+		// are left out
+		if err == errJobRunning || err == errJobNotFound {
+			continue
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		deleted = append(deleted, job)
+	}
+
+	respondWithJSON(w, listingsToAPIResponse(deleted))
+}
+
+// parseJobFilter reads a job filter from the query parameters of a request
+func parseJobFilter(r *http.Request) (jobFilter, error) {
+	var (
+		query  = r.URL.Query()
+		filter = jobFilter{Package: query.Get("pkg")}
+		err    error
+	)
+
+	if status := query.Get("status"); status != "" {
+		for _, name := range strings.Split(status, ",") {
+			state, ok := api.JobSummary_State_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok {
+				return jobFilter{}, fmt.Errorf("unknown status %q", name)
+			}
+			filter.States = append(filter.States, api.JobSummary_State(state))
+		}
+	}
+	if since := query.Get("since"); since != "" {
+		filter.CreatedAfter, err = time.Parse(time.RFC3339, since)
+		if err != nil {
+			return jobFilter{}, fmt.Errorf("invalid since: %w", err)
+		}
+	}
+	if until := query.Get("until"); until != "" {
+		filter.CreatedBefore, err = time.Parse(time.RFC3339, until)
+		if err != nil {
+			return jobFilter{}, fmt.Errorf("invalid until: %w", err)
+		}
+	}
+
+	return filter, nil
 }
 
 // StartJob begins processing a job according to the posted job config
@@ -91,24 +356,18 @@ func (c *Handler) StartJob(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-
-	var sortFunc testSortingFunction
-	switch req.GetSort() {
-	case api.StartJobRequest_RAW:
-		sortFunc = sortTestsByRawLinesCovered
-	case api.StartJobRequest_NET:
-		sortFunc = sortTestsByNewLinesCovered
-	case api.StartJobRequest_IMPORTANCE:
-		sortFunc = sortTestsByImportance
-	case api.StartJobRequest_HARDCODED:
-		sortFunc = sortHardcodedOrder(req.GetTests())
+	err = ValidateTestOptions(req.GetOptions())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = ValidateSortConstraints(req.GetConstraints())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	id := c.Jobs.StartJob(JobConfig{
-		pkg:   req.GetPkg(),
-		tests: req.GetTests(),
-		sort:  sortFunc,
-	})
+	id := c.Jobs.StartJob(NewJobConfig(req.GetPkg(), req.GetTests(), req.GetSort(), req.GetOptions(), req.GetFailurePolicy(), req.GetConstraints()))
 
 	respondWithJSON(w, api.StartJobResponse{Id: id})
 }
@@ -124,20 +383,215 @@ func respondWithJSON(w http.ResponseWriter, content interface{}) {
 	return
 }
 
-func cacheEntryToAPIResponse(e *jobCacheEntry) api.JobStatusResponse {
+func cacheEntryToAPIResponse(e *jobCacheEntry) *api.JobStatusResponse {
 	var filemaps []*api.FileMap
 	for _, fm := range e.Results.Files {
 		filemaps = append(filemaps, &api.FileMap{Files: fm})
 	}
 
-	return api.JobStatusResponse{
-		Complete: e.Complete,
-		Details:  e.Details,
-		Error:    e.Error,
+	var diffs []*api.StepDiff
+	for _, step := range e.Results.Diffs {
+		diffs = append(diffs, fileDiffsToAPIStepDiff(step))
+	}
+
+	return &api.JobStatusResponse{
+		Complete:  e.Complete,
+		Cancelled: e.Cancelled,
+		Details:   e.Details,
+		Error:     e.Error,
+		Progress:  progressToAPI(e.Progress),
+		Metadata:  metadataToAPI(e.Metadata),
 		Results: &api.JobResults{
 			Tests: e.Results.Tests,
 			Files: filemaps,
+			Diffs: diffs,
+			Kinds: testKinds(e.Results.Tests),
+			TestResults: outcomesToAPI(e.Results.Outcomes),
+			RedundantTests: redundantTests(e.Results.Outcomes),
+			Refinements: refinements(e.Results.Tests, e.Results.Outcomes),
+			Chapters: chapters(e.Results.Tests, e.Results.Outcomes),
+			Metrics: metricsToAPI(e.Results.Tests, e.Results.Outcomes),
 		},
 	}
 }
 
+func metadataToAPI(m jobMetadata) *api.JobMetadata {
+	out := &api.JobMetadata{
+		Pkg:        m.Package,
+		Sort:       m.SortType,
+		Tests:      m.Tests,
+		DurationMs: m.duration(time.Now()).Milliseconds(),
+	}
+	if !m.Created.IsZero() {
+		out.CreatedAtMs = m.Created.UnixNano() / int64(time.Millisecond)
+	}
+	if !m.Finished.IsZero() {
+		out.FinishedAtMs = m.Finished.UnixNano() / int64(time.Millisecond)
+	}
+	return out
+}
+
+func listingsToAPIResponse(jobs []jobListing) *api.ListJobsResponse {
+	out := &api.ListJobsResponse{}
+	for _, job := range jobs {
+		out.Jobs = append(out.Jobs, &api.JobSummary{
+			Id:       job.ID,
+			State:    jobState(job.Summary),
+			Details:  job.Summary.Details,
+			Error:    job.Summary.Error,
+			Metadata: metadataToAPI(job.Summary.Metadata),
+		})
+	}
+	return out
+}
+
+func testKinds(tests []string) []api.TestKind {
+	var kinds []api.TestKind
+	for _, test := range tests {
+		kinds = append(kinds, testKind(test))
+	}
+	return kinds
+}
+
+func outcomesToAPI(outcomes []testOutcome) []*api.TestResult {
+	var results []*api.TestResult
+	for _, o := range outcomes {
+		results = append(results, &api.TestResult{
+			Test:       o.Test,
+			Outcome:    o.Outcome,
+			Output:     o.Output,
+			DurationMs: o.Duration.Milliseconds(),
+			Subtests:   o.Subtests,
+		})
+	}
+	return results
+}
+
+func redundantTests(outcomes []testOutcome) []string {
+	var tests []string
+	for _, o := range outcomes {
+		if o.Redundant {
+			tests = append(tests, o.Test)
+		}
+	}
+	return tests
+}
+
+// refinements returns the tests of the log that refine others, in the order
+// of the log
+func refinements(tests []string, outcomes []testOutcome) []*api.TestRefinement {
+	refines := map[string][]string{}
+	for _, o := range outcomes {
+		refines[o.Test] = o.Refines
+	}
+
+	var out []*api.TestRefinement
+	for _, test := range tests {
+		if len(refines[test]) > 0 {
+			out = append(out, &api.TestRefinement{Test: test, Refines: refines[test]})
+		}
+	}
+	return out
+}
+
+// chapters groups the tests of the log into their chapters
+func chapters(tests []string, outcomes []testOutcome) []*api.Chapter {
+	chapterByTest := map[string]string{}
+	for _, o := range outcomes {
+		chapterByTest[o.Test] = o.Chapter
+	}
+
+	var out []*api.Chapter
+	for _, test := range tests {
+		title := chapterByTest[test]
+		if title == "" {
+			continue
+		}
+		if len(out) == 0 || out[len(out)-1].Title != title {
+			out = append(out, &api.Chapter{Title: title})
+		}
+		last := out[len(out)-1]
+		last.Tests = append(last.Tests, test)
+	}
+	return out
+}
+
+// metricsToAPI returns the metrics of the tests of the log, in the order of
+// the log
+func metricsToAPI(tests []string, outcomes []testOutcome) []*api.TestMetrics {
+	metricsByTest := map[string]*testMetrics{}
+	for _, o := range outcomes {
+		metricsByTest[o.Test] = o.Metrics
+	}
+
+	var out []*api.TestMetrics
+	for _, test := range tests {
+		m := metricsByTest[test]
+		if m == nil {
+			continue
+		}
+		out = append(out, &api.TestMetrics{
+			Test:         test,
+			LinesCovered: int32(m.LinesCovered),
+			NewLines:     int32(m.NewLines),
+			Overlap:      int32(m.Overlap),
+			Importance:   m.Importance,
+		})
+	}
+	return out
+}
+
+func fileDiffsToAPIStepDiff(diffs []fileDiff) *api.StepDiff {
+	stepDiff := &api.StepDiff{}
+	for _, fd := range diffs {
+		stepDiff.Files = append(stepDiff.Files, &api.FileDiff{
+			Name:    fd.Name,
+			Added:   int32(fd.Added),
+			Removed: int32(fd.Removed),
+			Unified: fd.Unified,
+		})
+	}
+	return stepDiff
+}
+
+func eventToAPIEvent(e jobEvent) *api.JobEvent {
+	out := &api.JobEvent{
+		Type:    e.Type,
+		Message: e.Message,
+	}
+
+	switch e.Type {
+	case api.JobEvent_STATUS:
+		out.Progress = progressToAPI(&e.Progress)
+	case api.JobEvent_STEP:
+		out.Step = int32(e.Step)
+		out.Test = e.Test
+		out.Kind = testKind(e.Test)
+		out.Diff = fileDiffsToAPIStepDiff(e.Diff)
+	case api.JobEvent_DONE:
+		out.Status = cacheEntryToAPIResponse(e.Status)
+	}
+
+	return out
+}
+
+func progressToAPI(p *jobProgress) *api.JobProgress {
+	if p == nil {
+		return nil
+	}
+
+	elapsed := map[string]int64{}
+	for phase, d := range p.PhaseElapsed {
+		elapsed[phase.String()] = d.Milliseconds()
+	}
+
+	return &api.JobProgress{
+		Phase:          p.Phase,
+		TestsDone:      int32(p.TestsDone),
+		TestsTotal:     int32(p.TestsTotal),
+		StepsDone:      int32(p.StepsDone),
+		StepsTotal:     int32(p.StepsTotal),
+		PhaseElapsedMs: elapsed,
+		EtaMs:          p.ETA.Milliseconds(),
+	}
+}
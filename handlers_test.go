@@ -10,7 +10,9 @@ import (
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"commitlog/api"
 
@@ -21,12 +23,17 @@ type mockLanguageProvider struct{}
 func (mlp mockLanguageProvider) ListPackages() ([]string, error) {
 	return []string{"package-1", "package-2"}, nil
 }
-func (mlp mockLanguageProvider) ListTests(pkg string) ([]string, error) {
-	return []string{"test-1", "test-2"}, nil
+func (mlp mockLanguageProvider) ListTests(pkg string, opts *api.TestOptions) ([]string, error) {
+	tests := []string{"test-1", "test-2"}
+	if len(opts.GetTags()) > 0 {
+		tests = append(tests, "test-tagged")
+	}
+	return tests, nil
 }
 
 type mockJobManager struct {
-	cache map[string]*jobCacheEntry
+	cache  map[string]*jobCacheEntry
+	events map[string][]jobEvent
 }
 func (mjm mockJobManager) StartJob(conf JobConfig) string {
 	return "id-1"
@@ -34,6 +41,44 @@ func (mjm mockJobManager) StartJob(conf JobConfig) string {
 func (mjm mockJobManager) JobStatus(id string) (*jobCacheEntry, error) {
 	return mjm.cache[id], nil
 }
+func (mjm mockJobManager) CancelJob(id string) bool {
+	status, ok := mjm.cache[id]
+	return ok && jobState(status.summary()) == api.JobSummary_RUNNING
+}
+func (mjm mockJobManager) ListJobs(filter jobFilter) ([]jobListing, error) {
+	var out []jobListing
+	for id, status := range mjm.cache {
+		if summary := status.summary(); filter.matches(summary) {
+			out = append(out, jobListing{ID: id, Summary: summary})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+func (mjm mockJobManager) DeleteJob(id string) error {
+	status, ok := mjm.cache[id]
+	if !ok {
+		return errJobNotFound
+	}
+	if jobState(status.summary()) == api.JobSummary_RUNNING {
+		return errJobRunning
+	}
+	delete(mjm.cache, id)
+	return nil
+}
+func (mjm mockJobManager) JobEvents(ctx context.Context, id string) (<-chan jobEvent, bool) {
+	events, ok := mjm.events[id]
+	if !ok {
+		return nil, false
+	}
+
+	out := make(chan jobEvent, len(events))
+	for _, e := range events {
+		out <- e
+	}
+	close(out)
+	return out, true
+}
 
 func TestPackagesHandler(t *testing.T) {
 	req, err := http.NewRequest("GET", "", nil)
@@ -74,7 +119,7 @@ func TestCheckoutFilesHandler(t *testing.T) {
 	checkoutRequest := api.CheckoutFilesRequest{Files: &api.FileMap{
 		Files: map[string][]byte{f.Name(): []byte(fileContent)},
 	}}
-	bs, err := json.Marshal(checkoutRequest)
+	bs, err := json.Marshal(&checkoutRequest)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -127,9 +172,72 @@ func TestTestsHandler(t *testing.T) {
 	}
 }
 
+func TestTestsHandler_Options(t *testing.T) {
+	handler := Handler{
+		Jobs:         mockJobManager{},
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedTests  []string
+	}{
+		{
+			name:           "no options",
+			body:           `{"pkg": "package-1"}`,
+			expectedStatus: http.StatusOK,
+			expectedTests:  []string{"test-1", "test-2"},
+		},
+		{
+			name:           "build tags",
+			body:           `{"pkg": "package-1", "options": {"tags": ["extra"]}}`,
+			expectedStatus: http.StatusOK,
+			expectedTests:  []string{"test-1", "test-2", "test-tagged"},
+		},
+		{
+			name:           "invalid options",
+			body:           `{"pkg": "package-1", "options": {"timeout": "soon"}}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid request",
+			body:           `not json`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("POST", "", bytes.NewBufferString(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.Tests(rr, req)
+
+		if rr.Code != test.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", test.name, test.expectedStatus, rr.Code)
+			continue
+		}
+		if test.expectedTests == nil {
+			continue
+		}
+		var response []string
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		if err != nil {
+			t.Errorf("%s: expected []string response, couldn't unmarshall", test.name)
+		}
+		if !reflect.DeepEqual(response, test.expectedTests) {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expectedTests, response)
+		}
+	}
+}
+
 func TestStartJobHandler(t *testing.T) {
 	jobRequest := api.StartJobRequest{}
-	bs, err := json.Marshal(jobRequest)
+	bs, err := json.Marshal(&jobRequest)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -159,6 +267,45 @@ func TestStartJobHandler(t *testing.T) {
 	}
 }
 
+func TestStartJobHandler_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        *api.TestOptions
+		constraints *api.SortConstraints
+	}{
+		{"timeout", &api.TestOptions{Timeout: "ten minutes"}, nil},
+		{"count", &api.TestOptions{Count: -1}, nil},
+		{"env", &api.TestOptions{Env: map[string]string{"A=B": "C"}}, nil},
+		{"cover mode", &api.TestOptions{CoverMode: "sometimes"}, nil},
+		{"constraints", nil, &api.SortConstraints{First: []string{"TestA"}, Last: []string{"TestA"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bs, err := json.Marshal(&api.StartJobRequest{Pkg: "pkg", Options: tt.opts, Constraints: tt.constraints})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, err := http.NewRequest("POST", "", bytes.NewReader(bs))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			jobManager := mockJobManager{cache: map[string]*jobCacheEntry{}}
+			handler := Handler{
+				Jobs:         jobManager,
+				LanguageInfo: mockLanguageProvider{},
+			}
+
+			rr := httptest.NewRecorder()
+			handler.StartJob(rr, req)
+
+			if rr.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d: %s", http.StatusBadRequest, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
+
 func TestJobStatusHandler(t *testing.T) {
 	req, err := http.NewRequest("POST", "", nil)
 	if err != nil {
@@ -198,17 +345,48 @@ func TestJobStatusHandler(t *testing.T) {
 	}
 }
 
+func TestJobStatusHandler_NotFound(t *testing.T) {
+	jobManager := mockJobManager{cache: map[string]*jobCacheEntry{
+		"deleted": {Complete: true},
+	}}
+	if err := jobManager.DeleteJob("deleted"); err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	for _, id := range []string{"deleted", "unknown"} {
+		req, err := http.NewRequest("GET", "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := httptest.NewRecorder()
+		handler.JobStatus(rr, req)
+
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("%s: expected status %d, got %d", id, http.StatusNotFound, rr.Code)
+		}
+	}
+}
+
 func TestCacheEntryToAPIResponse(t *testing.T) {
 	type test struct {
 		input *jobCacheEntry
-		expectedOutput api.JobStatusResponse
+		expectedOutput *api.JobStatusResponse
 	}
 	tests := []test{
 		{
 			input: &jobCacheEntry{
 				Complete: true,
 			},
-			expectedOutput: api.JobStatusResponse{
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
 				Complete: true,
 				Results: &api.JobResults{},
 			},
@@ -217,7 +395,8 @@ func TestCacheEntryToAPIResponse(t *testing.T) {
 			input: &jobCacheEntry{
 				Details: "details",
 			},
-			expectedOutput: api.JobStatusResponse{
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
 				Details: "details",
 				Results: &api.JobResults{},
 			},
@@ -226,7 +405,8 @@ func TestCacheEntryToAPIResponse(t *testing.T) {
 			input: &jobCacheEntry{
 				Error: "error",
 			},
-			expectedOutput: api.JobStatusResponse{
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
 				Error: "error",
 				Results: &api.JobResults{},
 			},
@@ -241,9 +421,11 @@ func TestCacheEntryToAPIResponse(t *testing.T) {
 					},
 				},
 			},
-			expectedOutput: api.JobStatusResponse{
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
 				Results: &api.JobResults{
 					Tests: []string{"one", "two"},
+					Kinds: []api.TestKind{api.TestKind_TEST, api.TestKind_TEST},
 					Files: []*api.FileMap{
 						{Files: map[string][]byte{
 							"f1":[]byte("oneContent"),
@@ -255,13 +437,157 @@ func TestCacheEntryToAPIResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: &jobCacheEntry{
+				Results: jobResult{
+					Diffs: [][]fileDiff{
+						{{Name: "f1", Added: 1, Unified: "@@ -0,0 +1 @@\n+oneContent\n"}},
+					},
+				},
+			},
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
+				Results: &api.JobResults{
+					Diffs: []*api.StepDiff{
+						{Files: []*api.FileDiff{
+							{Name: "f1", Added: 1, Unified: "@@ -0,0 +1 @@\n+oneContent\n"},
+						}},
+					},
+				},
+			},
+		},
+		{
+			input: &jobCacheEntry{
+				Results: jobResult{
+					Outcomes: []testOutcome{
+						{Test: "one", Duration: 1500 * time.Millisecond, Subtests: []string{"one/a"}},
+						{Test: "two", Outcome: api.TestOutcome_FAIL, Output: "--- FAIL: two"},
+						{Test: "three", Redundant: true},
+					},
+				},
+			},
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
+				Results: &api.JobResults{
+					TestResults: []*api.TestResult{
+						{Test: "one", DurationMs: 1500, Subtests: []string{"one/a"}},
+						{Test: "two", Outcome: api.TestOutcome_FAIL, Output: "--- FAIL: two"},
+						{Test: "three"},
+					},
+					RedundantTests: []string{"three"},
+				},
+			},
+		},
+		{
+			input: &jobCacheEntry{
+				Results: jobResult{
+					Tests: []string{"one", "two"},
+					Outcomes: []testOutcome{
+						{Test: "two", Refines: []string{"one"}},
+						{Test: "one"},
+					},
+				},
+			},
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
+				Results: &api.JobResults{
+					Tests: []string{"one", "two"},
+					Kinds: []api.TestKind{api.TestKind_TEST, api.TestKind_TEST},
+					TestResults: []*api.TestResult{
+						{Test: "two"},
+						{Test: "one"},
+					},
+					Refinements: []*api.TestRefinement{
+						{Test: "two", Refines: []string{"one"}},
+					},
+				},
+			},
+		},
+		{
+			input: &jobCacheEntry{
+				Results: jobResult{
+					Tests: []string{"one", "two", "three"},
+					Outcomes: []testOutcome{
+						{Test: "three", Chapter: "format"},
+						{Test: "one", Chapter: "parse"},
+						{Test: "two", Chapter: "parse"},
+					},
+				},
+			},
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
+				Results: &api.JobResults{
+					Tests: []string{"one", "two", "three"},
+					Kinds: []api.TestKind{api.TestKind_TEST, api.TestKind_TEST, api.TestKind_TEST},
+					TestResults: []*api.TestResult{
+						{Test: "three"},
+						{Test: "one"},
+						{Test: "two"},
+					},
+					Chapters: []*api.Chapter{
+						{Title: "parse", Tests: []string{"one", "two"}},
+						{Title: "format", Tests: []string{"three"}},
+					},
+				},
+			},
+		},
+		{
+			input: &jobCacheEntry{
+				Results: jobResult{
+					Tests: []string{"two", "one"},
+					Outcomes: []testOutcome{
+						{Test: "one", Metrics: &testMetrics{LinesCovered: 4, NewLines: 1, Overlap: 3, Importance: 1.5}},
+						{Test: "two", Metrics: &testMetrics{LinesCovered: 3, NewLines: 3, Importance: 2}},
+						{Test: "three"},
+					},
+				},
+			},
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
+				Results: &api.JobResults{
+					Tests: []string{"two", "one"},
+					Kinds: []api.TestKind{api.TestKind_TEST, api.TestKind_TEST},
+					TestResults: []*api.TestResult{
+						{Test: "one"},
+						{Test: "two"},
+						{Test: "three"},
+					},
+					Metrics: []*api.TestMetrics{
+						{Test: "two", LinesCovered: 3, NewLines: 3, Importance: 2},
+						{Test: "one", LinesCovered: 4, NewLines: 1, Overlap: 3, Importance: 1.5},
+					},
+				},
+			},
+		},
+		{
+			input: &jobCacheEntry{
+				Progress: &jobProgress{
+					Phase:        api.JobProgress_COLLECTING_COVERAGE,
+					TestsDone:    1,
+					TestsTotal:   2,
+					PhaseElapsed: map[api.JobProgress_Phase]time.Duration{api.JobProgress_COLLECTING_COVERAGE: time.Second},
+					ETA:          time.Second,
+				},
+			},
+			expectedOutput: &api.JobStatusResponse{
+				Metadata: &api.JobMetadata{},
+				Progress: &api.JobProgress{
+					Phase:          api.JobProgress_COLLECTING_COVERAGE,
+					TestsDone:      1,
+					TestsTotal:     2,
+					PhaseElapsedMs: map[string]int64{"COLLECTING_COVERAGE": 1000},
+					EtaMs:          1000,
+				},
+				Results: &api.JobResults{},
+			},
+		},
 	}
 
 	for i, test := range tests {
 		actualOutput := cacheEntryToAPIResponse(test.input)
 		if !reflect.DeepEqual(actualOutput, test.expectedOutput) {
-			log.Println(*actualOutput.Results)
-			log.Println(*test.expectedOutput.Results)
+			log.Println(actualOutput.Results)
+			log.Println(test.expectedOutput.Results)
 			t.Errorf("case %d unexpected output, expected:\n%#v\ngot\n%#v", i, actualOutput, test.expectedOutput)
 		}
 	}
@@ -284,3 +610,268 @@ func TestRespondWithJson(t *testing.T) {
 	}
 }
 
+
+func TestPatchesHandler(t *testing.T) {
+	jobManager := mockJobManager{cache: map[string]*jobCacheEntry{
+		"complete": {
+			Complete: true,
+			Results: jobResult{
+				Tests: []string{"TestOne"},
+				Files: []map[string][]byte{
+					{"/pkg/a.go": []byte("one\n")},
+					{"/pkg/a.go": []byte("one\ntwo\n")},
+				},
+			},
+		},
+		"incomplete": {Details: "running"},
+	}}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	tests := []struct {
+		id             string
+		expectedStatus int
+	}{
+		{id: "complete", expectedStatus: http.StatusOK},
+		{id: "incomplete", expectedStatus: http.StatusConflict},
+		{id: "missing", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("GET", "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", test.id)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := httptest.NewRecorder()
+		handler.Patches(rr, req)
+
+		if rr.Code != test.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", test.id, test.expectedStatus, rr.Code)
+		}
+	}
+}
+
+func TestExportRepositoryHandler(t *testing.T) {
+	jobManager := mockJobManager{cache: map[string]*jobCacheEntry{
+		"complete":   {Complete: true},
+		"incomplete": {Details: "running"},
+	}}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	tests := []struct {
+		id             string
+		body           string
+		expectedStatus int
+	}{
+		{id: "complete", body: `{}`, expectedStatus: http.StatusBadRequest},
+		{id: "complete", body: `{"dir": ""}`, expectedStatus: http.StatusBadRequest},
+		{id: "complete", body: `not json`, expectedStatus: http.StatusBadRequest},
+		{id: "incomplete", body: `{"dir": "/tmp/log"}`, expectedStatus: http.StatusConflict},
+		{id: "missing", body: `{"dir": "/tmp/log"}`, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("POST", "", bytes.NewBufferString(test.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", test.id)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := httptest.NewRecorder()
+		handler.ExportRepository(rr, req)
+
+		if rr.Code != test.expectedStatus {
+			t.Errorf("%s %s: expected status %d, got %d", test.id, test.body, test.expectedStatus, rr.Code)
+		}
+	}
+}
+
+func TestJobEventsHandler(t *testing.T) {
+	req, err := http.NewRequest("GET", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "id-1")
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+	jobManager := mockJobManager{events: map[string][]jobEvent{
+		"id-1": {
+			{Type: api.JobEvent_STATUS, Message: "Computing test ordering"},
+			{Type: api.JobEvent_DONE, Status: &jobCacheEntry{Complete: true}},
+		},
+	}}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	rr := httptest.NewRecorder()
+	handler.JobEvents(rr, req)
+
+	if contentType := rr.Header().Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("unexpected content type: %s", contentType)
+	}
+
+	expectedBody := "id: 0\nevent: status\ndata: {\"message\":\"Computing test ordering\",\"progress\":{}}\n\n" +
+		"id: 1\nevent: done\ndata: {\"type\":2,\"status\":{\"complete\":true,\"results\":{},\"metadata\":{}}}\n\n"
+	if body := rr.Body.String(); body != expectedBody {
+		t.Errorf("unexpected body:\n%s\nexpected:\n%s", body, expectedBody)
+	}
+}
+
+func TestListJobsHandler(t *testing.T) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	jobManager := mockJobManager{cache: map[string]*jobCacheEntry{
+		"complete": {Complete: true, Metadata: jobMetadata{Package: "pkg", Created: created, Finished: created.Add(time.Second)}},
+		"failed":   {Error: "failed", Metadata: jobMetadata{Package: "pkg", Created: created.Add(time.Hour)}},
+		"other":    {Complete: true, Metadata: jobMetadata{Package: "other", Created: created}},
+	}}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	tests := []struct {
+		query          string
+		expectedStatus int
+		expectedIDs    []string
+	}{
+		{query: "", expectedStatus: http.StatusOK, expectedIDs: []string{"complete", "failed", "other"}},
+		{query: "pkg=pkg", expectedStatus: http.StatusOK, expectedIDs: []string{"complete", "failed"}},
+		{query: "status=failed,cancelled", expectedStatus: http.StatusOK, expectedIDs: []string{"failed"}},
+		{query: "since=2021-06-01T12:30:00Z", expectedStatus: http.StatusOK, expectedIDs: []string{"failed"}},
+		{query: "until=2021-06-01T12:30:00Z&pkg=pkg", expectedStatus: http.StatusOK, expectedIDs: []string{"complete"}},
+		{query: "status=unknown", expectedStatus: http.StatusBadRequest},
+		{query: "since=yesterday", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("GET", "/jobs?"+test.query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.ListJobs(rr, req)
+
+		if rr.Code != test.expectedStatus {
+			t.Errorf("%q: expected status %d, got %d", test.query, test.expectedStatus, rr.Code)
+			continue
+		}
+		if rr.Code != http.StatusOK {
+			continue
+		}
+
+		var response api.ListJobsResponse
+		err = json.NewDecoder(rr.Body).Decode(&response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, job := range response.Jobs {
+			ids = append(ids, job.Id)
+		}
+		if !reflect.DeepEqual(ids, test.expectedIDs) {
+			t.Errorf("%q: expected jobs %v, got %v", test.query, test.expectedIDs, ids)
+		}
+	}
+}
+
+func TestDeleteJobHandler(t *testing.T) {
+	jobManager := mockJobManager{cache: map[string]*jobCacheEntry{
+		"complete": {Complete: true},
+		"running":  {Details: "running"},
+	}}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	tests := []struct {
+		id             string
+		expectedStatus int
+	}{
+		{id: "complete", expectedStatus: http.StatusNoContent},
+		{id: "complete", expectedStatus: http.StatusNotFound},
+		{id: "running", expectedStatus: http.StatusNoContent},
+		{id: "missing", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest("DELETE", "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", test.id)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		rr := httptest.NewRecorder()
+		handler.DeleteJob(rr, req)
+
+		if rr.Code != test.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", test.id, test.expectedStatus, rr.Code)
+		}
+	}
+
+	if _, ok := jobManager.cache["running"]; !ok {
+		t.Errorf("expected the cancelled job to be kept")
+	}
+}
+
+func TestDeleteJobsHandler(t *testing.T) {
+	jobManager := mockJobManager{cache: map[string]*jobCacheEntry{
+		"complete": {Complete: true},
+		"failed":   {Error: "failed"},
+		"running":  {Details: "running"},
+	}}
+	handler := Handler{
+		Jobs:         jobManager,
+		LanguageInfo: mockLanguageProvider{},
+	}
+
+	req, err := http.NewRequest("DELETE", "/jobs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	handler.DeleteJobs(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected unfiltered delete to be rejected, got status %d", rr.Code)
+	}
+
+	req, err = http.NewRequest("DELETE", "/jobs?status=failed,running", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr = httptest.NewRecorder()
+	handler.DeleteJobs(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var response api.ListJobsResponse
+	err = json.NewDecoder(rr.Body).Decode(&response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Jobs) != 1 || response.Jobs[0].Id != "failed" {
+		t.Errorf("expected only the failed job to be deleted, got %v", response.Jobs)
+	}
+	if _, ok := jobManager.cache["running"]; !ok {
+		t.Errorf("expected running job to be kept")
+	}
+}
@@ -0,0 +1,299 @@
+package commitlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"commitlog/api"
+)
+
+var (
+	errJobNotFound = errors.New("job not found")
+	errJobRunning  = errors.New("job is still running")
+)
+
+// errJobInterrupted is the error of jobs that were running when the server
+// stopped
+const errJobInterrupted = "job was interrupted before it finished"
+
+// jobMetadata records what a job was started with and when
+type jobMetadata struct {
+	Package  string
+	SortType api.StartJobRequest_SortType
+	Tests    []string
+	Created  time.Time
+	// Finished is zero until the job finishes
+	Finished time.Time
+	// Fingerprint identifies jobs computing the same log, it is empty if
+	// the source of the package couldn't be hashed
+	Fingerprint string
+}
+
+func newJobMetadata(conf JobConfig, created time.Time) jobMetadata {
+	return jobMetadata{
+		Package:  conf.pkg,
+		SortType: conf.sortType,
+		Tests:    conf.tests,
+		Created:  created,
+	}
+}
+
+// duration returns how long the job took, or how long it has been running
+// for at now if it hasn't finished
+func (m jobMetadata) duration(now time.Time) time.Duration {
+	if m.Created.IsZero() {
+		return 0
+	}
+	if !m.Finished.IsZero() {
+		return m.Finished.Sub(m.Created)
+	}
+	return now.Sub(m.Created)
+}
+
+// jobSummary is the status of a job without its results or progress, which
+// is all that's needed to list jobs
+type jobSummary struct {
+	Complete  bool
+	Cancelled bool
+	Details   string
+	Error     string
+	Metadata  jobMetadata
+}
+
+func (e jobCacheEntry) summary() jobSummary {
+	return jobSummary{
+		Complete:  e.Complete,
+		Cancelled: e.Cancelled,
+		Details:   e.Details,
+		Error:     e.Error,
+		Metadata:  e.Metadata,
+	}
+}
+
+// finished reports whether the job has stopped running, whether or not it
+// succeeded
+func (s jobSummary) finished() bool {
+	return s.Complete || s.Cancelled || s.Error != ""
+}
+
+// jobState summarizes the status of a job
+func jobState(s jobSummary) api.JobSummary_State {
+	switch {
+	case s.Complete:
+		return api.JobSummary_COMPLETE
+	case s.Cancelled:
+		return api.JobSummary_CANCELLED
+	case s.Error != "":
+		return api.JobSummary_FAILED
+	default:
+		return api.JobSummary_RUNNING
+	}
+}
+
+// jobFilter selects jobs by package, state and creation time. Zero fields
+// match every job.
+type jobFilter struct {
+	Package       string
+	States        []api.JobSummary_State
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+func (f jobFilter) isEmpty() bool {
+	return f.Package == "" && len(f.States) == 0 && f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero()
+}
+
+func (f jobFilter) matches(s jobSummary) bool {
+	if f.Package != "" && s.Metadata.Package != f.Package {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && s.Metadata.Created.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !s.Metadata.Created.Before(f.CreatedBefore) {
+		return false
+	}
+	if len(f.States) == 0 {
+		return true
+	}
+
+	state := jobState(s)
+	for _, s := range f.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// jobFingerprint identifies the log computed by a job from its config and
+// the current source of its package. It returns an empty string if the
+// source can't be hashed, in which case the job can't be deduplicated.
+func (c *commitlogApp) jobFingerprint(conf JobConfig) string {
+	hasher, ok := c.testRunner.(sourceHasher)
+	if !ok {
+		return ""
+	}
+	sourceHash, err := hasher.SourceHash(conf.pkg, conf.options.GetCoverPkgs())
+	if err != nil {
+		return ""
+	}
+
+	// The order of the tests only matters when it's the order of the log
+	tests := append([]string(nil), conf.tests...)
+	if conf.sortType != api.StartJobRequest_HARDCODED {
+		sort.Strings(tests)
+	}
+
+	options, err := testOptionsKey(conf.options)
+	if err != nil {
+		return ""
+	}
+	constraints, err := json.Marshal(conf.constraints)
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00", conf.pkg, conf.sortType, sourceHash, options, conf.failurePolicy, constraints)
+	for _, test := range tests {
+		fmt.Fprintf(h, "%s\x00", test)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// duplicateJobLocked returns the id of a running or complete job with the
+// given fingerprint. jobsMu must be held.
+func (c *commitlogApp) duplicateJobLocked(fingerprint string) (string, bool) {
+	if fingerprint == "" {
+		return "", false
+	}
+
+	id, ok := c.fingerprints[fingerprint]
+	if !ok {
+		return "", false
+	}
+	if _, running := c.cancelFuncs[id]; running {
+		return id, true
+	}
+	if summary, ok := c.jobSummaries.Read(id).(jobSummary); ok && summary.Complete {
+		return id, true
+	}
+	return "", false
+}
+
+// jobListing is the summary of a job along with its identifier
+type jobListing struct {
+	ID      string
+	Summary jobSummary
+}
+
+// ListJobs returns the summary of every job matching filter, most recently
+// created first
+func (c *commitlogApp) ListJobs(filter jobFilter) ([]jobListing, error) {
+	var out []jobListing
+	for _, id := range c.jobSummaries.Keys() {
+		info := c.jobSummaries.Read(id)
+		// The job may have been deleted since listing the keys
+		if info == nil {
+			continue
+		}
+		summary, ok := info.(jobSummary)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type in job summaries: %#v", info)
+		}
+		if c.interrupted(id, summary) {
+			summary = jobSummary{Error: errJobInterrupted, Metadata: summary.Metadata}
+		}
+		if filter.matches(summary) {
+			out = append(out, jobListing{ID: id, Summary: summary})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i].Summary.Metadata.Created, out[j].Summary.Metadata.Created
+		if a.Equal(b) {
+			return out[i].ID < out[j].ID
+		}
+		return a.After(b)
+	})
+	return out, nil
+}
+
+// writeJob stores the status of a job in the job cache, along with its
+// summary
+func (c *commitlogApp) writeJob(id string, entry jobCacheEntry) {
+	c.jobCache.Write(id, entry)
+	c.jobSummaries.Write(id, entry.summary())
+}
+
+// summarizeJobs adds the summaries missing for jobs in the job cache, which
+// were stored before their summaries were
+func (c *commitlogApp) summarizeJobs() {
+	for _, id := range c.jobCache.Keys() {
+		if c.jobSummaries.Read(id) != nil {
+			continue
+		}
+		if entry, ok := c.jobCache.Read(id).(jobCacheEntry); ok {
+			c.jobSummaries.Write(id, entry.summary())
+		}
+	}
+}
+
+// loadFingerprints indexes the fingerprints of the complete jobs in the job
+// cache, which may have completed before a restart, keeping the latest job
+// for each fingerprint
+func (c *commitlogApp) loadFingerprints() {
+	c.jobsMu.Lock()
+	defer c.jobsMu.Unlock()
+
+	created := map[string]time.Time{}
+	for _, id := range c.jobSummaries.Keys() {
+		summary, ok := c.jobSummaries.Read(id).(jobSummary)
+		fingerprint := summary.Metadata.Fingerprint
+		if !ok || !summary.Complete || fingerprint == "" {
+			continue
+		}
+		if latest, ok := created[fingerprint]; ok && latest.After(summary.Metadata.Created) {
+			continue
+		}
+		created[fingerprint] = summary.Metadata.Created
+		c.fingerprints[fingerprint] = id
+	}
+}
+
+// interrupted reports whether a job that hasn't finished isn't running
+// either, because it was interrupted by the server stopping. It's never
+// going to finish.
+func (c *commitlogApp) interrupted(id string, s jobSummary) bool {
+	if s.finished() {
+		return false
+	}
+	c.jobsMu.Lock()
+	defer c.jobsMu.Unlock()
+	_, running := c.cancelFuncs[id]
+	return !running
+}
+
+// DeleteJob removes a finished job and its results. Running jobs have to be
+// cancelled and finish before they can be deleted.
+func (c *commitlogApp) DeleteJob(id string) error {
+	c.jobsMu.Lock()
+	defer c.jobsMu.Unlock()
+
+	if _, running := c.cancelFuncs[id]; running {
+		return errJobRunning
+	}
+	if c.jobCache.Read(id) == nil {
+		return errJobNotFound
+	}
+
+	c.jobCache.Delete(id)
+	c.jobSummaries.Delete(id)
+	return nil
+}
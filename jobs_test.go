@@ -0,0 +1,206 @@
+package commitlog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"commitlog/api"
+	memCache "commitlog/cache"
+)
+
+func TestJobFilterMatches(t *testing.T) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	summary := jobSummary{
+		Error:    "failed",
+		Metadata: jobMetadata{Package: "pkg", Created: created},
+	}
+
+	tests := []struct {
+		filter   jobFilter
+		expected bool
+	}{
+		{filter: jobFilter{}, expected: true},
+		{filter: jobFilter{Package: "pkg"}, expected: true},
+		{filter: jobFilter{Package: "other"}, expected: false},
+		{filter: jobFilter{States: []api.JobSummary_State{api.JobSummary_COMPLETE, api.JobSummary_FAILED}}, expected: true},
+		{filter: jobFilter{States: []api.JobSummary_State{api.JobSummary_RUNNING}}, expected: false},
+		{filter: jobFilter{CreatedAfter: created}, expected: true},
+		{filter: jobFilter{CreatedAfter: created.Add(time.Second)}, expected: false},
+		{filter: jobFilter{CreatedBefore: created}, expected: false},
+		{filter: jobFilter{CreatedBefore: created.Add(time.Second)}, expected: true},
+	}
+
+	for i, test := range tests {
+		if got := test.filter.matches(summary); got != test.expected {
+			t.Errorf("case %d: expected %t, got %t", i, test.expected, got)
+		}
+	}
+}
+
+func TestJobMetadataDuration(t *testing.T) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	now := created.Add(time.Minute)
+
+	running := jobMetadata{Created: created}
+	if d := running.duration(now); d != time.Minute {
+		t.Errorf("expected running job to have taken a minute so far, got %s", d)
+	}
+	finished := jobMetadata{Created: created, Finished: created.Add(time.Second)}
+	if d := finished.duration(now); d != time.Second {
+		t.Errorf("expected finished job to have taken a second, got %s", d)
+	}
+}
+
+func TestListAndDeleteJobs(t *testing.T) {
+	app := mockApp()
+	app.testRunner = mockFileRunner{}
+
+	tests := []string{"TestFuncOne"}
+	id := app.StartJob(NewJobConfig("testdata", tests, api.StartJobRequest_HARDCODED, nil, api.StartJobRequest_FAIL_FAST, nil))
+	if err := app.DeleteJob(id); err != errJobRunning && err != nil {
+		t.Errorf("unexpected error deleting running job: %v", err)
+	}
+	events, _ := app.JobEvents(context.Background(), id)
+	for range events {
+	}
+
+	jobs, err := app.ListJobs(jobFilter{Package: "testdata", States: []api.JobSummary_State{api.JobSummary_COMPLETE}})
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != id {
+		t.Fatalf("expected to list the completed job, got: %#v", jobs)
+	}
+	meta := jobs[0].Summary.Metadata
+	if meta.SortType != api.StartJobRequest_HARDCODED || !reflect.DeepEqual(meta.Tests, tests) || meta.Finished.Before(meta.Created) {
+		t.Errorf("unexpected job metadata: %#v", meta)
+	}
+
+	if err := app.DeleteJob(id); err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if err := app.DeleteJob(id); err != errJobNotFound {
+		t.Errorf("expected deleted job to be missing, got: %v", err)
+	}
+	if jobs, _ := app.ListJobs(jobFilter{}); len(jobs) != 0 {
+		t.Errorf("expected no jobs after deleting, got: %#v", jobs)
+	}
+}
+
+func TestListJobs_ReadsSummaries(t *testing.T) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	app := mockApp()
+	app.jobCache = memCache.From(map[string]interface{}{
+		"done": jobCacheEntry{
+			Complete: true,
+			Results:  jobResult{Tests: []string{"TestFuncOne"}},
+			Metadata: jobMetadata{Package: "pkg", Created: created},
+		},
+		"stopped": jobCacheEntry{
+			Details:  "Computing coverage",
+			Metadata: jobMetadata{Package: "pkg", Created: created.Add(time.Second)},
+		},
+	})
+	// Jobs stored before their summaries are summarized when the app starts
+	app.summarizeJobs()
+
+	// Listing jobs doesn't need their results
+	app.jobCache = memCache.New()
+	jobs, err := app.ListJobs(jobFilter{})
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	expected := []jobListing{
+		{ID: "stopped", Summary: jobSummary{Error: errJobInterrupted, Metadata: jobMetadata{Package: "pkg", Created: created.Add(time.Second)}}},
+		{ID: "done", Summary: jobSummary{Complete: true, Metadata: jobMetadata{Package: "pkg", Created: created}}},
+	}
+	if !reflect.DeepEqual(jobs, expected) {
+		t.Errorf("unexpected jobs, got: %#v, expected: %#v", jobs, expected)
+	}
+}
+
+type mockHashingRunner struct {
+	mockFileRunner
+	hash *string
+}
+
+func (m mockHashingRunner) SourceHash(pkg string, coverPkgs []string) (string, error) {
+	return *m.hash, nil
+}
+
+func TestStartJob_Deduplicates(t *testing.T) {
+	hash := "source-1"
+	app := mockApp()
+	app.testRunner = mockHashingRunner{hash: &hash}
+
+	wait := func(id string) {
+		events, _ := app.JobEvents(context.Background(), id)
+		for range events {
+		}
+	}
+
+	tests := []string{"TestFuncOne", "TestFuncTwo"}
+	first := app.StartJob(NewJobConfig("testdata", tests, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, nil))
+	if id := app.StartJob(NewJobConfig("testdata", tests, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, nil)); id != first {
+		t.Errorf("expected running job %s to be reused, got %s", first, id)
+	}
+	wait(first)
+
+	reordered := []string{"TestFuncTwo", "TestFuncOne"}
+	if id := app.StartJob(NewJobConfig("testdata", reordered, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, nil)); id != first {
+		t.Errorf("expected completed job %s to be reused, got %s", first, id)
+	}
+
+	hardcoded := app.StartJob(NewJobConfig("testdata", tests, api.StartJobRequest_HARDCODED, nil, api.StartJobRequest_FAIL_FAST, nil))
+	if hardcoded == first {
+		t.Errorf("expected a different sort to start a new job")
+	}
+	wait(hardcoded)
+	if id := app.StartJob(NewJobConfig("testdata", reordered, api.StartJobRequest_HARDCODED, nil, api.StartJobRequest_FAIL_FAST, nil)); id == hardcoded {
+		t.Errorf("expected a different hardcoded order to start a new job")
+	}
+
+	hash = "source-2"
+	changed := app.StartJob(NewJobConfig("testdata", tests, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, nil))
+	if changed == first {
+		t.Errorf("expected changed source to start a new job")
+	}
+	wait(changed)
+
+	if err := app.DeleteJob(changed); err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+	if id := app.StartJob(NewJobConfig("testdata", tests, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, nil)); id == changed {
+		t.Errorf("expected deleted job not to be reused")
+	}
+}
+
+func TestStartJob_DeduplicatesAfterRestart(t *testing.T) {
+	hash := "source-1"
+	runner := mockHashingRunner{hash: &hash}
+	tests := []string{"TestFuncOne", "TestFuncTwo"}
+	conf := NewJobConfig("testdata", tests, api.StartJobRequest_RAW, nil, api.StartJobRequest_FAIL_FAST, nil)
+	fingerprint := (&commitlogApp{testRunner: runner}).jobFingerprint(conf)
+
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	complete := func(created time.Time) jobCacheEntry {
+		return jobCacheEntry{
+			Complete: true,
+			Metadata: jobMetadata{Package: "testdata", Created: created, Fingerprint: fingerprint},
+		}
+	}
+	// The caches of a server that ran the same job twice before restarting
+	jobCache := memCache.From(map[string]interface{}{
+		"older":  complete(created),
+		"latest": complete(created.Add(time.Minute)),
+		"failed": jobCacheEntry{Error: "failed", Metadata: jobMetadata{Created: created.Add(time.Hour), Fingerprint: fingerprint}},
+	})
+	app := NewCommitLogApp(runner, memCache.New(), jobCache, memCache.New())
+
+	if id := app.StartJob(conf); id != "latest" {
+		t.Errorf("expected the latest completed job to be reused, got %s", id)
+	}
+}
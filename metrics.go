@@ -0,0 +1,69 @@
+package commitlog
+
+import (
+	"golang.org/x/tools/cover"
+)
+
+// testMetrics describes what a test adds at its step of a log, to explain
+// the order a sort chose
+type testMetrics struct {
+	// LinesCovered is the number of lines the test covers
+	LinesCovered int
+	// NewLines is the number of those lines the steps before it don't cover
+	NewLines int
+	// Overlap is the number of those lines the steps before it cover
+	Overlap int
+	// Importance is the average number of tests covering each line the test
+	// covers, which is what the IMPORTANCE sort ranks tests by
+	Importance float64
+}
+
+// sortWithMetrics returns a sorting function that orders tests as sortFunc
+// does, then reports the metrics of each test at its step of the order
+func sortWithMetrics(sortFunc testSortingFunction) testSortingFunction {
+	return func(testProfiles testProfileData, durations testDurations) sortResult {
+		result := sortFunc(testProfiles, durations)
+		result.Metrics = map[string]testMetrics{}
+		for i, m := range orderMetrics(result.Order, testProfiles) {
+			result.Metrics[result.Order[i]] = m
+		}
+		return result
+	}
+}
+
+// orderMetrics returns the metrics of each test of a log, in the order of
+// the log. The importance of a test is scored against every test in
+// testProfiles, as sortTestsByImportance does, whether or not it's in the
+// log.
+func orderMetrics(tests []string, testProfiles testProfileData) []testMetrics {
+	var allProfiles []*cover.Profile
+	for _, profiles := range testProfiles {
+		allProfiles = append(allProfiles, profiles...)
+	}
+	lineWeights := scoreLines(allProfiles)
+
+	var (
+		metrics []testMetrics
+		covered = map[string]map[int]struct{}{}
+	)
+	for _, test := range tests {
+		lines := coveredLines(testProfiles[test]...)
+		m := testMetrics{
+			LinesCovered: newLines(lines, nil),
+			NewLines:     newLines(lines, covered),
+			Importance:   scoreProfiles(testProfiles[test], lineWeights),
+		}
+		m.Overlap = m.LinesCovered - m.NewLines
+		metrics = append(metrics, m)
+
+		for file, fileLines := range lines {
+			if covered[file] == nil {
+				covered[file] = map[int]struct{}{}
+			}
+			for line := range fileLines {
+				covered[file][line] = struct{}{}
+			}
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,71 @@
+package commitlog
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/tools/cover"
+)
+
+func TestOrderMetrics(t *testing.T) {
+	lines := func(ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		"TestOne":   lines([2]int{1, 4}),
+		"TestTwo":   lines([2]int{3, 6}),
+		"TestThree": lines([2]int{1, 6}),
+		// A block on a single line still covers that line
+		"TestSingle": lines([2]int{8, 8}),
+		// Left out of the log, but still covers lines 1 to 3
+		"TestLeftOut": lines([2]int{1, 3}),
+	}
+
+	// Lines 1, 2 and 4 are covered by three tests, 3 by four, 5 and 6 by
+	// two and 8 by one
+	expected := []testMetrics{
+		{LinesCovered: 4, NewLines: 4, Overlap: 0, Importance: 3.25},
+		{LinesCovered: 4, NewLines: 2, Overlap: 2, Importance: 2.75},
+		{LinesCovered: 6, NewLines: 0, Overlap: 6, Importance: 17.0 / 6},
+		{LinesCovered: 1, NewLines: 1, Overlap: 0, Importance: 1},
+	}
+	actual := orderMetrics([]string{"TestOne", "TestTwo", "TestThree", "TestSingle"}, profiles)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestSortWithMetrics(t *testing.T) {
+	lines := func(ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		"TestBase":    lines([2]int{1, 3}),
+		"TestWide":    lines([2]int{1, 5}),
+		"ExampleWide": lines([2]int{1, 5}),
+	}
+
+	// The metrics follow the order the wrapped sorts leave, with the
+	// example moved in front of the test covering fewer lines
+	result := sortWithMetrics(sortExamplesFirst(sortTestsByRawLinesCovered))(profiles, testDurations{})
+	expectedOrder := []string{"ExampleWide", "TestBase", "TestWide"}
+	if !reflect.DeepEqual(result.Order, expectedOrder) {
+		t.Fatalf("Expected %v, got %v", expectedOrder, result.Order)
+	}
+	expectedMetrics := map[string]testMetrics{
+		"ExampleWide": {LinesCovered: 5, NewLines: 5, Overlap: 0, Importance: 2.6},
+		"TestBase":    {LinesCovered: 3, NewLines: 0, Overlap: 3, Importance: 3},
+		"TestWide":    {LinesCovered: 5, NewLines: 0, Overlap: 5, Importance: 2.6},
+	}
+	if !reflect.DeepEqual(result.Metrics, expectedMetrics) {
+		t.Errorf("Expected %+v, got %+v", expectedMetrics, result.Metrics)
+	}
+}
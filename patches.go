@@ -0,0 +1,127 @@
+package commitlog
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"commitlog/diff"
+)
+
+const (
+	patchAuthor       = "commitlog <commitlog@localhost>"
+	patchContextLines = 3
+)
+
+// WritePatchSeries writes the job results to w as a numbered series of patches
+// in the mbox format produced by git format-patch, so it can be applied with
+// git am. There is one patch per test, followed by one adding the remaining
+// code. As with format-patch, steps that don't change any files are left out
+// of the series. File names are relative to the deepest directory containing
+// all of the files.
+func WritePatchSeries(w io.Writer, result jobResult, date time.Time) error {
+	if len(result.Files) == 0 {
+		return fmt.Errorf("job has no results to export")
+	}
+
+	type patch struct {
+		subject string
+		body    string
+	}
+	var (
+		root    = commonDir(result.Files[len(result.Files)-1])
+		patches []patch
+		prev    = map[string][]byte{}
+	)
+
+	for i, files := range result.Files {
+		subject := finalCommitMessage
+		if i < len(result.Tests) {
+			subject = result.Tests[i]
+		}
+
+		body, err := stepPatch(root, prev, files)
+		if err != nil {
+			return err
+		}
+		if body != "" {
+			patches = append(patches, patch{subject: subject, body: body})
+		}
+		prev = files
+	}
+
+	width := len(fmt.Sprint(len(patches)))
+	for i, p := range patches {
+		_, err := fmt.Fprintf(w, "From 0000000000000000000000000000000000000000 Mon Sep 17 00:00:00 2001\n"+
+			"From: %s\n"+
+			"Date: %s\n"+
+			"Subject: [PATCH %0*d/%d] %s\n"+
+			"\n"+
+			"---\n"+
+			"%s"+
+			"-- \n"+
+			"commitlog\n"+
+			"\n",
+			patchAuthor, date.Format(time.RFC1123Z), width, i+1, len(patches), p.subject, p.body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stepPatch returns the git style diff between two steps of a job's results,
+// or an empty string if they are the same
+func stepPatch(root string, prev, current map[string][]byte) (string, error) {
+	var (
+		sb    strings.Builder
+		names []string
+		seen  = map[string]struct{}{}
+	)
+
+	for _, files := range []map[string][]byte{prev, current} {
+		for name := range files {
+			if _, ok := seen[name]; !ok {
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rel, err := filepath.Rel(root, name)
+		if err != nil {
+			return "", err
+		}
+		rel = filepath.ToSlash(rel)
+
+		before, existed := prev[name]
+		after, exists := current[name]
+		hunks := diff.Hunks(before, after, patchContextLines)
+		if existed && exists && len(hunks) == 0 {
+			continue
+		}
+
+		oldName, newName := "a/"+rel, "b/"+rel
+		fmt.Fprintf(&sb, "diff --git %s %s\n", oldName, newName)
+		if !existed {
+			sb.WriteString("new file mode 100644\n")
+			oldName = "/dev/null"
+		}
+		if !exists {
+			sb.WriteString("deleted file mode 100644\n")
+			newName = "/dev/null"
+		}
+		if len(hunks) > 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+			sb.WriteString(diff.Unified(hunks))
+		}
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,75 @@
+package commitlog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"commitlog/gitcmd"
+)
+
+func TestWritePatchSeries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	result := jobResult{
+		Tests: []string{"TestOne", "TestNoChange", "TestTwo"},
+		Files: []map[string][]byte{
+			{"/src/pkg/a.go": []byte("package pkg\n")},
+			{"/src/pkg/a.go": []byte("package pkg\n")},
+			{"/src/pkg/a.go": []byte("package pkg\n\nfunc A() {}\n"), "/src/pkg/sub/b.go": []byte("package sub")},
+			{"/src/pkg/a.go": []byte("package pkg\n\nfunc A() {}\n\nfunc B() {}\n"), "/src/pkg/sub/b.go": []byte("package sub")},
+		},
+	}
+
+	var buf bytes.Buffer
+	err = WritePatchSeries(&buf, result, time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	if !strings.Contains(buf.String(), "Subject: [PATCH 1/3] TestOne\n") {
+		t.Errorf("expected numbered subject for first patch, got:\n%s", buf.String())
+	}
+
+	err = gitcmd.Init(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command("git", "am", "--quiet")
+	cmd.Dir = dir
+	cmd.Stdin = &buf
+	cmd.Env = append(os.Environ(), "GIT_COMMITTER_NAME=commitlog", "GIT_COMMITTER_EMAIL=commitlog@localhost")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git am failed: %s: %s", err, out)
+	}
+
+	out, err = exec.Command("git", "-C", dir, "log", "--reverse", "--format=%s").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedLog := []string{"TestOne", "TestTwo", finalCommitMessage}
+	if actualLog := strings.Split(strings.TrimSpace(string(out)), "\n"); !reflect.DeepEqual(actualLog, expectedLog) {
+		t.Errorf("unexpected commit log, got: %s, expected: %s", actualLog, expectedLog)
+	}
+
+	for name, expected := range result.Files[len(result.Files)-1] {
+		actual, err := ioutil.ReadFile(filepath.Join(dir, strings.TrimPrefix(name, "/src/pkg/")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(actual) != string(expected) {
+			t.Errorf("unexpected content for %s after applying patches, got: %q, expected: %q", name, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package commitlog
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"commitlog/api"
+)
+
+// jobProgress is a snapshot of how far a job has got
+type jobProgress struct {
+	Phase      api.JobProgress_Phase
+	Message    string
+	TestsDone  int
+	TestsTotal int
+	StepsDone  int
+	StepsTotal int
+	// PhaseElapsed is the total time spent in each phase so far
+	PhaseElapsed map[api.JobProgress_Phase]time.Duration
+	// ETA estimates how long until the tests or steps currently being
+	// processed are finished. It is 0 when there's no estimate.
+	ETA time.Duration
+}
+
+// progressTracker follows a job through its phases, reporting a snapshot of
+// its progress after every change. It is safe for concurrent use.
+type progressTracker struct {
+	mu           sync.Mutex
+	progress     jobProgress
+	phaseStarted time.Time
+	// stageStarted is when the first test or step of the current stage
+	// started, for estimating how long the rest will take
+	stageStarted time.Time
+	report       func(jobProgress)
+	now          func() time.Time
+}
+
+func newProgressTracker(report func(jobProgress)) *progressTracker {
+	return &progressTracker{
+		progress: jobProgress{
+			PhaseElapsed: map[api.JobProgress_Phase]time.Duration{},
+		},
+		report: report,
+		now:    time.Now,
+	}
+}
+
+// start sets the number of tests and steps the job will process
+func (t *progressTracker) start(tests, steps int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress.TestsTotal = tests
+	t.progress.StepsTotal = steps
+	t.phaseStarted = t.now()
+}
+
+// setSteps changes the number of steps the job will build, for when tests
+// are left out of the log
+func (t *progressTracker) setSteps(steps int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress.StepsTotal = steps
+}
+
+// startPhase moves the job to a new phase
+func (t *progressTracker) startPhase(phase api.JobProgress_Phase, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.updateElapsed()
+	if phase == api.JobProgress_COLLECTING_COVERAGE || (phase == api.JobProgress_BUILDING_STEP && t.progress.StepsDone == 0) {
+		t.stageStarted = now
+	}
+	t.progress.Phase = phase
+	t.progress.Message = message
+	t.reportLocked()
+}
+
+// testDone records that coverage has been collected for another test
+func (t *progressTracker) testDone() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress.TestsDone++
+	t.updateElapsed()
+	t.progress.ETA = t.estimate(t.progress.TestsDone, t.progress.TestsTotal)
+	t.progress.Message = fmt.Sprintf("Computed coverage for %d of %d tests", t.progress.TestsDone, t.progress.TestsTotal)
+	t.reportLocked()
+}
+
+// stepDone records that another step of the log has been built
+func (t *progressTracker) stepDone() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.progress.StepsDone++
+	t.updateElapsed()
+	t.progress.ETA = t.estimate(t.progress.StepsDone, t.progress.StepsTotal)
+	t.progress.Message = fmt.Sprintf("Constructed diff %d of %d", t.progress.StepsDone, t.progress.StepsTotal)
+	t.reportLocked()
+}
+
+// updateElapsed adds the time since it was last called to the current phase,
+// and returns the current time
+func (t *progressTracker) updateElapsed() time.Time {
+	now := t.now()
+	if !t.phaseStarted.IsZero() {
+		t.progress.PhaseElapsed[t.progress.Phase] += now.Sub(t.phaseStarted)
+	}
+	t.phaseStarted = now
+	return now
+}
+
+// estimate extrapolates the time remaining for a stage from the average time
+// taken by the items of it done so far
+func (t *progressTracker) estimate(done, total int) time.Duration {
+	if done == 0 || t.stageStarted.IsZero() {
+		return 0
+	}
+
+	perItem := t.now().Sub(t.stageStarted) / time.Duration(done)
+	return perItem * time.Duration(total-done)
+}
+
+func (t *progressTracker) reportLocked() {
+	snapshot := t.progress
+	snapshot.PhaseElapsed = map[api.JobProgress_Phase]time.Duration{}
+	for phase, elapsed := range t.progress.PhaseElapsed {
+		snapshot.PhaseElapsed[phase] = elapsed
+	}
+	t.report(snapshot)
+}
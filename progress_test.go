@@ -0,0 +1,55 @@
+package commitlog
+
+import (
+	"testing"
+	"time"
+
+	"commitlog/api"
+)
+
+func TestProgressTracker(t *testing.T) {
+	var (
+		now     = time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+		reports []jobProgress
+	)
+	tracker := newProgressTracker(func(p jobProgress) {
+		reports = append(reports, p)
+	})
+	tracker.now = func() time.Time { return now }
+
+	tracker.start(4, 4)
+	tracker.startPhase(api.JobProgress_COLLECTING_COVERAGE, "collecting")
+	now = now.Add(2 * time.Second)
+	tracker.testDone()
+
+	last := reports[len(reports)-1]
+	if last.TestsDone != 1 || last.TestsTotal != 4 {
+		t.Errorf("unexpected test counts, got %d of %d", last.TestsDone, last.TestsTotal)
+	}
+	if last.ETA != 6*time.Second {
+		t.Errorf("expected ETA of 6s after one of four tests took 2s, got %s", last.ETA)
+	}
+
+	now = now.Add(time.Second)
+	tracker.startPhase(api.JobProgress_SORTING, "sorting")
+	now = now.Add(500 * time.Millisecond)
+	tracker.startPhase(api.JobProgress_BUILDING_STEP, "building")
+
+	last = reports[len(reports)-1]
+	if last.Phase != api.JobProgress_BUILDING_STEP || last.Message != "building" {
+		t.Errorf("unexpected phase %s with message %s", last.Phase, last.Message)
+	}
+	if elapsed := last.PhaseElapsed[api.JobProgress_COLLECTING_COVERAGE]; elapsed != 3*time.Second {
+		t.Errorf("expected 3s collecting coverage, got %s", elapsed)
+	}
+	if elapsed := last.PhaseElapsed[api.JobProgress_SORTING]; elapsed != 500*time.Millisecond {
+		t.Errorf("expected 500ms sorting, got %s", elapsed)
+	}
+
+	now = now.Add(time.Second)
+	tracker.stepDone()
+	last = reports[len(reports)-1]
+	if last.StepsDone != 1 || last.ETA != 3*time.Second {
+		t.Errorf("unexpected step progress, got %d steps done with ETA %s", last.StepsDone, last.ETA)
+	}
+}
@@ -2,24 +2,104 @@ package commitlog
 
 import (
 	"sort"
+	"strings"
+	"time"
+
+	"commitlog/api"
 
 	"golang.org/x/tools/cover"
 )
 
 type testProfileData map[string][]*cover.Profile
 
-type testSortingFunction func(testProfileData) []string
+// testDurations holds how long each test took to run
+type testDurations map[string]time.Duration
+
+// before orders tests that a sort has no other preference between, quickest
+// first so that the log starts with tests that are cheap to rerun, then by
+// name so that the order doesn't depend on map iteration
+func (d testDurations) before(a, b string) bool {
+	if d[a] != d[b] {
+		return d[a] < d[b]
+	}
+	return a < b
+}
+
+// sortResult is what a sort makes of the tests of a log
+type sortResult struct {
+	// Order is the order of the log. HARDCODED orders may include tests
+	// that aren't in the log.
+	Order []string
+	// Metrics describes what each test adds at its step of Order
+	Metrics map[string]testMetrics
+	// Refines holds the tests each test directly refines, for sorts that
+	// order tests after the tests they refine
+	Refines map[string][]string
+	// Chapters holds the title of the chapter of each test, for sorts that
+	// group tests into chapters
+	Chapters map[string]string
+}
+
+type testSortingFunction func(testProfileData, testDurations) sortResult
+
+// testKind returns the kind of function a test is from its name
+func testKind(test string) api.TestKind {
+	switch {
+	case strings.HasPrefix(test, "Example"):
+		return api.TestKind_EXAMPLE
+	case strings.HasPrefix(test, "Fuzz"):
+		return api.TestKind_FUZZ
+	default:
+		return api.TestKind_TEST
+	}
+}
+
+// sortExamplesFirst returns a sorting function that orders tests using
+// sortFunc, then moves examples in front of the other tests, since they're
+// usually the most readable introduction to a package
+func sortExamplesFirst(sortFunc testSortingFunction) testSortingFunction {
+	return func(testProfiles testProfileData, durations testDurations) sortResult {
+		result := sortFunc(testProfiles, durations)
+		tests := result.Order
+		sort.SliceStable(tests, func(i, j int) bool {
+			return testKind(tests[i]) == api.TestKind_EXAMPLE && testKind(tests[j]) != api.TestKind_EXAMPLE
+		})
+		return result
+	}
+}
 
 // sortHardcodedOrder returns a sorting function that always produces
 // the specified ordering
 func sortHardcodedOrder(order []string) testSortingFunction {
-	return func(testProfileData) []string {
-		return order
+	return func(testProfileData, testDurations) sortResult {
+		return sortResult{Order: order}
 	}
 }
 
 // sortTestsByRawLinesCovered sorts tests by the number of lines they cover
-func sortTestsByRawLinesCovered(testProfiles testProfileData) []string {
+func sortTestsByRawLinesCovered(testProfiles testProfileData, durations testDurations) sortResult {
+	var tests []string
+	coverageByTest := map[string]int{}
+
+	for test, profiles := range testProfiles {
+		tests = append(tests, test)
+		coverageByTest[test] = numLinesCovered(profiles...)
+	}
+
+	sort.Slice(tests, func(i, j int) bool {
+		iCount := coverageByTest[tests[i]]
+		jCount := coverageByTest[tests[j]]
+		if iCount != jCount {
+			return iCount < jCount
+		}
+		return durations.before(tests[i], tests[j])
+	})
+	return sortResult{Order: tests}
+}
+
+// sortTestsByDuration sorts tests by how long they took to run, quickest
+// first, then by the number of lines they cover
+func sortTestsByDuration(testProfiles testProfileData, durations testDurations) sortResult {
 	var tests []string
 	coverageByTest := map[string]int{}
 
@@ -29,21 +109,37 @@ func sortTestsByRawLinesCovered(testProfiles testProfileData) []string {
 	}
 
 	sort.Slice(tests, func(i, j int) bool {
+		iDuration := durations[tests[i]]
+		jDuration := durations[tests[j]]
+		if iDuration != jDuration {
+			return iDuration < jDuration
+		}
 		iCount := coverageByTest[tests[i]]
 		jCount := coverageByTest[tests[j]]
-		return iCount < jCount
+		if iCount != jCount {
+			return iCount < jCount
+		}
+		return tests[i] < tests[j]
 	})
-	return tests
+	return sortResult{Order: tests}
 }
 
 // sortTestsByNewLinesCovered sorts tests by calculating the number of lines of
 // coverage each test would add to the coverage provided by the already sorted
 // tests, and selecting the test which provides the smallest number of new lines
-func sortTestsByNewLinesCovered(testProfiles testProfileData) []string {
+func sortTestsByNewLinesCovered(testProfiles testProfileData, durations testDurations) sortResult {
+	sortedTests, _ := sortByNewLinesCovered(testProfiles, durations, nil)
+	return sortResult{Order: sortedTests}
+}
+
+// sortByNewLinesCovered sorts tests as sortTestsByNewLinesCovered does,
+// counting the lines each test adds to existingCoverage and the tests
+// sorted before it. It also returns the coverage of existingCoverage and
+// every test merged.
+func sortByNewLinesCovered(testProfiles testProfileData, durations testDurations, existingCoverage []*cover.Profile) ([]string, []*cover.Profile) {
 	var (
-		sortedTests      []string
-		tests            []string
-		existingCoverage []*cover.Profile
+		sortedTests []string
+		tests       []string
 	)
 
 	for test, _ := range testProfiles {
@@ -57,7 +153,8 @@ func sortTestsByNewLinesCovered(testProfiles testProfileData) []string {
 		for i, test := range tests {
 			profiles := testProfiles[test]
 			newCoverage, coverageGain := mergeProfiles(existingCoverage, profiles)
-			if minCoverageGain == -1 || coverageGain < minCoverageGain {
+			if minCoverageGain == -1 || coverageGain < minCoverageGain ||
+				(coverageGain == minCoverageGain && durations.before(test, tests[minTestIdx])) {
 				minTestIdx = i
 				minCoverageGain = coverageGain
 				minCoverage = newCoverage
@@ -67,13 +164,245 @@ func sortTestsByNewLinesCovered(testProfiles testProfileData) []string {
 		existingCoverage = minCoverage
 		tests = append(tests[:minTestIdx], tests[minTestIdx+1:]...)
 	}
-	return sortedTests
+	return sortedTests, existingCoverage
+}
+
+// sortTestsByMinimalCover picks a small set of tests that together cover every
+// line covered by all the tests, and sorts them as sortTestsByNewLinesCovered
+// does. The other tests are left out. Tests are picked greedily by the number
+// of lines they add, then picked tests whose lines are all covered by the
+// other picked tests are dropped, so every step of the log adds something.
+func sortTestsByMinimalCover(testProfiles testProfileData, durations testDurations) sortResult {
+	var (
+		tests       []string
+		linesByTest = map[string]map[string]map[int]struct{}{}
+		covered     = map[string]map[int]struct{}{}
+		picked      []string
+	)
+
+	for test, profiles := range testProfiles {
+		tests = append(tests, test)
+		linesByTest[test] = coveredLines(profiles...)
+	}
+	sort.Slice(tests, func(i, j int) bool {
+		return durations.before(tests[i], tests[j])
+	})
+
+	for {
+		best, bestGain := -1, 0
+		for i, test := range tests {
+			if gain := newLines(linesByTest[test], covered); gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+		if best == -1 {
+			break
+		}
+
+		test := tests[best]
+		picked = append(picked, test)
+		tests = append(tests[:best], tests[best+1:]...)
+		for file, lines := range linesByTest[test] {
+			if covered[file] == nil {
+				covered[file] = map[int]struct{}{}
+			}
+			for line := range lines {
+				covered[file][line] = struct{}{}
+			}
+		}
+	}
+
+	// Tests picked early may be covered by the ones picked after them
+	coveringTests := map[string]map[int]int{}
+	for _, test := range picked {
+		for file, lines := range linesByTest[test] {
+			if coveringTests[file] == nil {
+				coveringTests[file] = map[int]int{}
+			}
+			for line := range lines {
+				coveringTests[file][line]++
+			}
+		}
+	}
+	minimal := testProfileData{}
+	for i := len(picked) - 1; i >= 0; i-- {
+		test := picked[i]
+		redundant := true
+		for file, lines := range linesByTest[test] {
+			for line := range lines {
+				if coveringTests[file][line] == 1 {
+					redundant = false
+				}
+			}
+		}
+		if !redundant {
+			minimal[test] = testProfiles[test]
+			continue
+		}
+		for file, lines := range linesByTest[test] {
+			for line := range lines {
+				coveringTests[file][line]--
+			}
+		}
+	}
+
+	return sortTestsByNewLinesCovered(minimal, durations)
+}
+
+// sortTestsByContainment sorts tests so that every test comes after the
+// tests whose coverage its own coverage contains, as given by
+// containmentDAG. Among the tests whose contained tests are all sorted,
+// examples come first, then the one adding the fewest new lines comes next,
+// as in sortTestsByNewLinesCovered. Examples come as early as the tests they
+// refine allow, rather than first. The tests each test directly refines are
+// reported in the result.
+func sortTestsByContainment(testProfiles testProfileData, durations testDurations) sortResult {
+	var (
+		sortedTests      []string
+		tests            []string
+		existingCoverage []*cover.Profile
+		sorted           = map[string]bool{}
+		dag              = containmentDAG(testProfiles, durations)
+	)
+
+	for test := range testProfiles {
+		tests = append(tests, test)
+	}
+
+	for len(tests) > 0 {
+		minCoverageGain := -1
+		minTestIdx := 0
+		minExample := false
+		var minCoverage []*cover.Profile
+		for i, test := range tests {
+			ready := true
+			for _, contained := range dag[test] {
+				if !sorted[contained] {
+					ready = false
+				}
+			}
+			if !ready {
+				continue
+			}
+
+			example := testKind(test) == api.TestKind_EXAMPLE
+			newCoverage, coverageGain := mergeProfiles(existingCoverage, testProfiles[test])
+			better := minCoverageGain == -1 || (example && !minExample)
+			if example == minExample {
+				better = better || coverageGain < minCoverageGain ||
+					(coverageGain == minCoverageGain && durations.before(test, tests[minTestIdx]))
+			}
+			if better {
+				minTestIdx = i
+				minCoverageGain = coverageGain
+				minCoverage = newCoverage
+				minExample = example
+			}
+		}
+		sortedTests = append(sortedTests, tests[minTestIdx])
+		sorted[tests[minTestIdx]] = true
+		existingCoverage = minCoverage
+		tests = append(tests[:minTestIdx], tests[minTestIdx+1:]...)
+	}
+	return sortResult{Order: sortedTests, Refines: dag}
+}
+
+// containmentDAG returns, for each test, the tests whose covered lines are
+// all covered by it too, leaving out those contained in another test it
+// contains, so that each test is only linked to the tests it directly
+// refines. Of two tests covering the same lines, the one ordered first by
+// durations is contained in the other. Tests that cover nothing are left
+// out. The contained tests of each test are ordered by durations.
+func containmentDAG(testProfiles testProfileData, durations testDurations) map[string][]string {
+	var (
+		tests       []string
+		linesByTest = map[string]map[string]map[int]struct{}{}
+	)
+
+	for test, profiles := range testProfiles {
+		lines := coveredLines(profiles...)
+		if newLines(lines, nil) == 0 {
+			continue
+		}
+		tests = append(tests, test)
+		linesByTest[test] = lines
+	}
+	sort.Slice(tests, func(i, j int) bool {
+		return durations.before(tests[i], tests[j])
+	})
+
+	// contains[i][j] is set when tests[i] contains tests[j]
+	contains := make([][]bool, len(tests))
+	for i, test := range tests {
+		contains[i] = make([]bool, len(tests))
+		for j, other := range tests {
+			if i == j || newLines(linesByTest[other], linesByTest[test]) > 0 {
+				continue
+			}
+			// Tests covering the same lines contain each other, so
+			// only the one sorted last contains the other
+			if newLines(linesByTest[test], linesByTest[other]) > 0 || j < i {
+				contains[i][j] = true
+			}
+		}
+	}
+
+	dag := map[string][]string{}
+	for i, test := range tests {
+		for j, other := range tests {
+			if !contains[i][j] {
+				continue
+			}
+			direct := true
+			for k := range tests {
+				if contains[i][k] && contains[k][j] {
+					direct = false
+					break
+				}
+			}
+			if direct {
+				dag[test] = append(dag[test], other)
+			}
+		}
+	}
+	return dag
+}
+
+// newLines returns the number of lines that aren't in covered
+func newLines(lines, covered map[string]map[int]struct{}) int {
+	n := 0
+	for file, fileLines := range lines {
+		for line := range fileLines {
+			if _, ok := covered[file][line]; !ok {
+				n++
+			}
+		}
+	}
+	return n
 }
 
 // sortTestsByImportance sorts tests using an 'importance' heuristic
 // each line in a file is given a point for every test that covers it
 // then tests are ranked by the average value of the lines they cover
-func sortTestsByImportance(testProfiles testProfileData) []string {
+func sortTestsByImportance(testProfiles testProfileData, durations testDurations) sortResult {
+	return sortTestsByAverageWeight(testProfiles, durations, scoreLines, false)
+}
+
+// sortTestsByFrequency sorts tests so that those exercising the hottest code
+// come first. Each line is weighted by the number of times it ran across
+// every test, and tests are ranked by the average weight of the lines they
+// cover, highest first. Without hit counts from count or atomic mode
+// profiles, a line's weight is the number of tests covering it.
+func sortTestsByFrequency(testProfiles testProfileData, durations testDurations) sortResult {
+	return sortTestsByAverageWeight(testProfiles, durations, lineFrequencies, true)
+}
+
+// sortTestsByAverageWeight weights every line by calling lineWeights with the
+// profiles of all the tests, then ranks tests by the average weight of the
+// lines they cover, lowest first unless highestFirst is set. Tests with the
+// same average are ordered by the lines they cover, most first, then
+// quickest first.
+func sortTestsByAverageWeight(testProfiles testProfileData, durations testDurations, lineWeights func([]*cover.Profile) map[string]map[int]int, highestFirst bool) sortResult {
 	var (
 		allProfiles []*cover.Profile
 		tests       []string
@@ -84,20 +413,52 @@ func sortTestsByImportance(testProfiles testProfileData) []string {
 		allProfiles = append(allProfiles, profiles...)
 	}
 
-	lineWeights := scoreLines(allProfiles)
+	weights := lineWeights(allProfiles)
 
 	avgScoreByTest := map[string]float64{}
+	linesByTest := map[string]int{}
 	for test, profiles := range testProfiles {
-		avgScoreByTest[test] = scoreProfiles(profiles, lineWeights)
+		avgScoreByTest[test] = scoreProfiles(profiles, weights)
+		linesByTest[test] = numLinesCovered(profiles...)
 	}
 
 	sort.Slice(tests, func(i, j int) bool {
-		iCount := avgScoreByTest[tests[i]]
-		jCount := avgScoreByTest[tests[j]]
-		return iCount < jCount
+		iScore := avgScoreByTest[tests[i]]
+		jScore := avgScoreByTest[tests[j]]
+		if iScore != jScore && highestFirst {
+			return iScore > jScore
+		}
+		if iScore != jScore {
+			return iScore < jScore
+		}
+		if linesByTest[tests[i]] != linesByTest[tests[j]] {
+			return linesByTest[tests[i]] > linesByTest[tests[j]]
+		}
+		return durations.before(tests[i], tests[j])
 	})
 
-	return tests
+	return sortResult{Order: tests}
+}
+
+// lineFrequencies takes a list of profiles and computes the number of times
+// each line ran across all of them, which is returned in a
+// map[filename]map[line]count
+func lineFrequencies(profiles []*cover.Profile) map[string]map[int]int {
+	counts := map[string]map[int]int{}
+
+	for _, profile := range profiles {
+		if _, ok := counts[profile.FileName]; !ok {
+			counts[profile.FileName] = map[int]int{}
+		}
+
+		for _, b := range profile.Blocks {
+			for line := b.StartLine; line <= b.EndLine; line++ {
+				counts[profile.FileName][line] += b.Count
+			}
+		}
+	}
+
+	return counts
 }
 
 // scoreLines takes a list of profiles and computes a score for
@@ -113,7 +474,7 @@ func scoreLines(profiles []*cover.Profile) map[string]map[int]int {
 		}
 
 		for _, b := range profile.Blocks {
-			if b.Count != 1 {
+			if b.Count == 0 {
 				continue
 			}
 
@@ -134,7 +495,10 @@ func scoreProfiles(profiles []*cover.Profile, lineWeights map[string]map[int]int
 
 	for _, p := range profiles {
 		for _, b := range p.Blocks {
-			for line := b.StartLine; line < b.EndLine; line++ {
+			if b.Count == 0 {
+				continue
+			}
+			for line := b.StartLine; line <= b.EndLine; line++ {
 				totalLines += 1
 				totalScore += float64(lineWeights[p.FileName][line])
 			}
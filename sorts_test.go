@@ -4,6 +4,9 @@ import (
 	"golang.org/x/tools/cover"
 	"reflect"
 	"testing"
+	"time"
+
+	"commitlog/api"
 )
 
 func TestTestSorts(t *testing.T) {
@@ -68,12 +71,258 @@ func TestTestSorts(t *testing.T) {
 			sortingFunc: sortTestsByImportance,
 			expectedOrder: []string{"TestThree", "TestFour", "TestTwo", "TestOne"},
 		},
+		{
+			name: "Duration sort",
+			sortingFunc: sortTestsByDuration,
+			expectedOrder: []string{"TestFour", "TestThree", "TestOne", "TestTwo"},
+		},
 	}
 
 	for _, test := range tests {
-		actualOrder := test.sortingFunc(profiles)
+		actualOrder := test.sortingFunc(profiles, nil).Order
 		if !reflect.DeepEqual(actualOrder, test.expectedOrder) {
 			t.Errorf("%s: Expected %#v, got %#v", test.name, test.expectedOrder, actualOrder)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestSortTestsByImportanceTies(t *testing.T) {
+	// Every line is covered by a single test, so all the tests are equally
+	// important and only the tie-break decides their order
+	profiles := testProfileData{}
+	lines := map[string]int{"TestA": 3, "TestB": 5, "TestC": 3, "TestD": 5, "TestE": 2}
+	start := 1
+	for test, n := range lines {
+		profiles[test] = []*cover.Profile{
+			{
+				FileName: "File1.go",
+				Blocks: []cover.ProfileBlock{
+					{StartLine: start, StartCol: 0, EndLine: start + n - 1, EndCol: 10, Count: 1},
+				},
+			},
+		}
+		start += n
+	}
+	expectedOrder := []string{"TestB", "TestD", "TestA", "TestC", "TestE"}
+
+	// Sorting repeatedly catches an order that depends on map iteration
+	for i := 0; i < 50; i++ {
+		actualOrder := sortTestsByImportance(profiles, nil).Order
+		if !reflect.DeepEqual(actualOrder, expectedOrder) {
+			t.Fatalf("Expected %#v, got %#v", expectedOrder, actualOrder)
+		}
+	}
+}
+
+func TestSortExamplesFirst(t *testing.T) {
+	order := []string{"TestOne", "ExampleTwo", "FuzzThree", "ExampleFour", "TestFive"}
+	actual := sortExamplesFirst(sortHardcodedOrder(order))(testProfileData{}, nil).Order
+
+	expected := []string{"ExampleTwo", "ExampleFour", "TestOne", "FuzzThree", "TestFive"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestTestKind(t *testing.T) {
+	tests := map[string]api.TestKind{
+		"TestOne":           api.TestKind_TEST,
+		"TestTable/case":    api.TestKind_TEST,
+		"ExampleFormat":     api.TestKind_EXAMPLE,
+		"FuzzParse":         api.TestKind_FUZZ,
+		"FuzzParse/seed#00": api.TestKind_FUZZ,
+	}
+
+	for name, expected := range tests {
+		if actual := testKind(name); actual != expected {
+			t.Errorf("%s: Expected %s, got %s", name, expected, actual)
+		}
+	}
+}
+
+func TestTestSorts_FastTestsFirst(t *testing.T) {
+	block := []*cover.Profile{
+		{
+			FileName: "File1.go",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 0, StartCol: 0, EndLine: 10, EndCol: 10, Count: 1},
+			},
+		},
+	}
+	profiles := testProfileData{"TestA": block, "TestB": block, "TestC": block}
+	durations := testDurations{"TestA": 3 * time.Second, "TestB": time.Millisecond, "TestC": time.Second}
+
+	expected := []string{"TestB", "TestC", "TestA"}
+	sorts := map[string]testSortingFunction{
+		"raw":        sortTestsByRawLinesCovered,
+		"net":        sortTestsByNewLinesCovered,
+		"importance": sortTestsByImportance,
+		"frequency":  sortTestsByFrequency,
+	}
+	for name, sortFunc := range sorts {
+		if actual := sortFunc(profiles, durations).Order; !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%s: Expected %#v, got %#v", name, expected, actual)
+		}
+	}
+}
+
+func TestSortTestsByDuration(t *testing.T) {
+	lines := func(n int) []*cover.Profile {
+		return []*cover.Profile{
+			{
+				FileName: "File1.go",
+				Blocks: []cover.ProfileBlock{
+					{StartLine: 1, StartCol: 0, EndLine: n, EndCol: 10, Count: 1},
+				},
+			},
+		}
+	}
+	profiles := testProfileData{
+		"TestSlowSmall": lines(2),
+		"TestFastLarge": lines(20),
+		"TestFastSmall": lines(5),
+		"TestUntimed":   lines(50),
+	}
+	durations := testDurations{
+		"TestSlowSmall": 2 * time.Second,
+		"TestFastLarge": time.Millisecond,
+		"TestFastSmall": time.Millisecond,
+	}
+
+	// Unlike the tie-break of the other sorts, the duration outranks the
+	// lines covered
+	expected := []string{"TestUntimed", "TestFastSmall", "TestFastLarge", "TestSlowSmall"}
+	if actual := sortTestsByDuration(profiles, durations).Order; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestScoreLines_CountMode(t *testing.T) {
+	profiles := []*cover.Profile{
+		{
+			FileName: "File1.go",
+			Mode:     "count",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, EndLine: 2, Count: 4},
+				{StartLine: 3, EndLine: 3, Count: 0},
+			},
+		},
+		{
+			FileName: "File1.go",
+			Mode:     "count",
+			Blocks: []cover.ProfileBlock{
+				{StartLine: 1, EndLine: 2, Count: 1},
+			},
+		},
+	}
+
+	expected := map[string]map[int]int{"File1.go": {1: 2, 2: 2}}
+	if actual := scoreLines(profiles); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+}
+
+func TestSortTestsByFrequency(t *testing.T) {
+	profile := func(count int, lines ...int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, line := range lines {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: line, EndLine: line + 1, Count: count})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Mode: "count", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		// Runs the hot loop on lines 1-2 many times
+		"TestHot": profile(50, 1),
+		// Runs the hot loop once, and setup code on lines 10-11
+		"TestSetup": append(profile(1, 1), profile(1, 10)...),
+		// Only runs rarely used code
+		"TestCold": profile(1, 20),
+	}
+
+	expected := []string{"TestHot", "TestSetup", "TestCold"}
+	if actual := sortTestsByFrequency(profiles, nil).Order; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestSortTestsByMinimalCover(t *testing.T) {
+	lines := func(ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		// Picked first as it covers the most, then covered by the two
+		// tests picked after it
+		"TestMiddle": lines([2]int{2, 5}),
+		"TestStart":  lines([2]int{1, 3}),
+		"TestEnd":    lines([2]int{4, 6}),
+		"TestSubset": lines([2]int{2, 3}),
+		"TestNone":   lines(),
+	}
+
+	expected := []string{"TestEnd", "TestStart"}
+	durations := testDurations{"TestStart": time.Second}
+	if actual := sortTestsByMinimalCover(profiles, durations).Order; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestContainmentDAG(t *testing.T) {
+	lines := func(ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		"TestBase":     lines([2]int{1, 2}),
+		"TestSame":     lines([2]int{1, 2}),
+		"TestMore":     lines([2]int{1, 4}),
+		"TestAll":      lines([2]int{1, 4}, [2]int{8, 9}),
+		"TestDisjoint": lines([2]int{8, 9}),
+		"TestNone":     lines(),
+	}
+
+	// TestAll contains TestBase through TestMore, but only refines it
+	// directly
+	expected := map[string][]string{
+		"TestSame": {"TestBase"},
+		"TestMore": {"TestSame"},
+		"TestAll":  {"TestDisjoint", "TestMore"},
+	}
+	if actual := containmentDAG(profiles, testDurations{}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestSortTestsByContainment(t *testing.T) {
+	lines := func(ranges ...[2]int) []*cover.Profile {
+		var blocks []cover.ProfileBlock
+		for _, r := range ranges {
+			blocks = append(blocks, cover.ProfileBlock{StartLine: r[0], EndLine: r[1], Count: 1})
+		}
+		return []*cover.Profile{{FileName: "File1.go", Blocks: blocks}}
+	}
+	profiles := testProfileData{
+		// Once TestSmall is sorted, TestWide adds as many lines as TestBase
+		// and is quicker, but it contains TestBase so it has to come after
+		"TestSmall": lines([2]int{20, 21}),
+		"TestBase":  lines([2]int{1, 3}),
+		"TestWide":  lines([2]int{1, 3}, [2]int{20, 21}),
+	}
+
+	expected := []string{"TestSmall", "TestBase", "TestWide"}
+	durations := testDurations{"TestBase": time.Second}
+	result := sortTestsByContainment(profiles, durations)
+	if !reflect.DeepEqual(result.Order, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, result.Order)
+	}
+	expectedRefines := map[string][]string{"TestWide": {"TestSmall", "TestBase"}}
+	if !reflect.DeepEqual(result.Refines, expectedRefines) {
+		t.Errorf("Expected %#v, got %#v", expectedRefines, result.Refines)
+	}
+}
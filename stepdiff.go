@@ -0,0 +1,74 @@
+package commitlog
+
+import (
+	"sort"
+
+	"commitlog/diff"
+)
+
+const stepDiffContextLines = 3
+
+// fileDiff describes the changes made to a single file by a step of the log
+type fileDiff struct {
+	Name    string
+	Added   int
+	Removed int
+	Unified string
+}
+
+// stepDiffer computes the changes each step of a log makes to the files of
+// the previous step, one step at a time as the steps are computed. The first
+// step is compared to an empty set of files.
+type stepDiffer struct {
+	prev  map[string][]byte
+	diffs [][]fileDiff
+}
+
+// add diffs the next step of the log, which produces files, and returns its
+// changes. They're also kept in diffs.
+func (d *stepDiffer) add(files map[string][]byte) []fileDiff {
+	diff := diffStep(d.prev, files)
+	d.diffs = append(d.diffs, diff)
+	d.prev = files
+	return diff
+}
+
+// diffStep computes the changes made to the files in prev by a step
+// producing files. Unchanged files are left out.
+func diffStep(prev, files map[string][]byte) []fileDiff {
+	var (
+		out   []fileDiff
+		names []string
+	)
+
+	for name := range files {
+		names = append(names, name)
+	}
+	for name := range prev {
+		if _, ok := files[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		before, existed := prev[name]
+		after, exists := files[name]
+		hunks := diff.Hunks(before, after, stepDiffContextLines)
+		if existed == exists && len(hunks) == 0 {
+			continue
+		}
+
+		fd := fileDiff{
+			Name:    name,
+			Unified: diff.Unified(hunks),
+		}
+		for _, h := range hunks {
+			fd.Added += h.Added()
+			fd.Removed += h.Removed()
+		}
+		out = append(out, fd)
+	}
+
+	return out
+}
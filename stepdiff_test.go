@@ -0,0 +1,40 @@
+package commitlog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStepDiffer(t *testing.T) {
+	steps := []map[string][]byte{
+		{"a.go": []byte("one\n")},
+		{"a.go": []byte("one\n"), "b.go": []byte("two\n")},
+		{"a.go": []byte("uno\n"), "b.go": []byte("two\n")},
+		{"b.go": []byte("two\n")},
+	}
+
+	expected := [][]fileDiff{
+		{
+			{Name: "a.go", Added: 1, Unified: "@@ -0,0 +1 @@\n+one\n"},
+		},
+		{
+			{Name: "b.go", Added: 1, Unified: "@@ -0,0 +1 @@\n+two\n"},
+		},
+		{
+			{Name: "a.go", Added: 1, Removed: 1, Unified: "@@ -1 +1 @@\n-one\n+uno\n"},
+		},
+		{
+			{Name: "a.go", Removed: 1, Unified: "@@ -1 +0,0 @@\n-uno\n"},
+		},
+	}
+
+	var differ stepDiffer
+	for i, files := range steps {
+		if actual := differ.add(files); !reflect.DeepEqual(actual, expected[i]) {
+			t.Errorf("step %d: unexpected diffs, expected:\n%#v\ngot:\n%#v", i, expected[i], actual)
+		}
+	}
+	if !reflect.DeepEqual(differ.diffs, expected) {
+		t.Errorf("unexpected diffs, expected:\n%#v\ngot:\n%#v", expected, differ.diffs)
+	}
+}
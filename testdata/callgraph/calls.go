@@ -0,0 +1,26 @@
+package callgraph
+
+import "strings"
+
+type Greeter struct {
+	name string
+}
+
+func (g *Greeter) Greet() string {
+	return greeting(g.name)
+}
+
+func greeting(name string) string {
+	return "Hello, " + clean(name)
+}
+
+func clean(name string) string {
+	return strings.TrimSpace(name)
+}
+
+func Countdown(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return Countdown(n - 1)
+}
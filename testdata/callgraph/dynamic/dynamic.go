@@ -0,0 +1,42 @@
+package dynamic
+
+import "example.com/callgraph/dynamic/shout"
+
+type Speaker interface {
+	Speak(name string) string
+}
+
+type Loud struct{}
+
+func (Loud) Speak(name string) string {
+	return shout.Upper(name)
+}
+
+// Announce calls Speak through an interface
+func Announce(s Speaker, name string) string {
+	return s.Speak(name)
+}
+
+type Counter struct {
+	n int
+}
+
+func (c *Counter) Next() int {
+	c.n++
+	return c.n
+}
+
+// Skip calls Next through a method value
+func Skip(c *Counter) int {
+	next := c.Next
+	next()
+	return next()
+}
+
+// Twice calls Skip from a function literal
+func Twice(c *Counter) int {
+	add := func(n int) int {
+		return n + Skip(c)
+	}
+	return add(Skip(c))
+}
@@ -0,0 +1,11 @@
+package shout
+
+import "strings"
+
+func Upper(s string) string {
+	return strings.ToUpper(exclaim(s))
+}
+
+func exclaim(s string) string {
+	return s + "!"
+}
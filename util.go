@@ -12,12 +12,18 @@ import (
 // It returns the absolute path of a file given its package relative
 // location.
 // Ex:commitlog/demo/demo.go -> /Users/bhaines/repo/commitlog/demo/demo.go
+// Packages are looked up from the directory of pack when it is absolute,
+// so that files from other packages of its module can be found.
 func findFile(path string, pack string) (string, error) {
 	if filepath.IsAbs(path) {
 		return path, nil
 	}
+	ctxt, srcDir := build.Default, "."
+	if filepath.IsAbs(pack) {
+		ctxt.Dir, srcDir = pack, pack
+	}
 	dir, file := filepath.Split(path)
-	pkg, err := build.Import(dir, ".", build.FindOnly)
+	pkg, err := ctxt.Import(dir, srcDir, build.FindOnly)
 	if err != nil {
 		gp := os.Getenv("GOPATH")
 		if _, err := os.Stat(filepath.Join(pack, file)); err == nil || !os.IsNotExist(err) {